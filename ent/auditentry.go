@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"gin-crud/ent/auditentry"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AuditEntry is the model entity for the AuditEntry schema.
+type AuditEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID *int `json:"actor_id,omitempty"`
+	// EntityType holds the value of the "entity_type" field.
+	EntityType string `json:"entity_type,omitempty"`
+	// EntityID holds the value of the "entity_id" field.
+	EntityID int64 `json:"entity_id,omitempty"`
+	// Operation holds the value of the "operation" field.
+	Operation auditentry.Operation `json:"operation,omitempty"`
+	// Before holds the value of the "before" field.
+	Before map[string]interface{} `json:"before,omitempty"`
+	// After holds the value of the "after" field.
+	After map[string]interface{} `json:"after,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditentry.FieldBefore, auditentry.FieldAfter:
+			values[i] = new([]byte)
+		case auditentry.FieldID, auditentry.FieldActorID, auditentry.FieldEntityID:
+			values[i] = new(sql.NullInt64)
+		case auditentry.FieldEntityType, auditentry.FieldOperation:
+			values[i] = new(sql.NullString)
+		case auditentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditEntry fields.
+func (ae *AuditEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ae.ID = int(value.Int64)
+		case auditentry.FieldActorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				ae.ActorID = new(int)
+				*ae.ActorID = int(value.Int64)
+			}
+		case auditentry.FieldEntityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_type", values[i])
+			} else if value.Valid {
+				ae.EntityType = value.String
+			}
+		case auditentry.FieldEntityID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				ae.EntityID = value.Int64
+			}
+		case auditentry.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				ae.Operation = auditentry.Operation(value.String)
+			}
+		case auditentry.FieldBefore:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field before", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ae.Before); err != nil {
+					return fmt.Errorf("unmarshal field before: %w", err)
+				}
+			}
+		case auditentry.FieldAfter:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field after", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ae.After); err != nil {
+					return fmt.Errorf("unmarshal field after: %w", err)
+				}
+			}
+		case auditentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ae.CreatedAt = value.Time
+			}
+		default:
+			ae.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditEntry.
+// This includes values selected through modifiers, order, etc.
+func (ae *AuditEntry) Value(name string) (ent.Value, error) {
+	return ae.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditEntry.
+// Note that you need to call AuditEntry.Unwrap() before calling this method if this AuditEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *AuditEntry) Update() *AuditEntryUpdateOne {
+	return NewAuditEntryClient(ae.config).UpdateOne(ae)
+}
+
+// Unwrap unwraps the AuditEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *AuditEntry) Unwrap() *AuditEntry {
+	_tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditEntry is not a transactional entity")
+	}
+	ae.config.driver = _tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *AuditEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ae.ID))
+	if v := ae.ActorID; v != nil {
+		builder.WriteString("actor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("entity_type=")
+	builder.WriteString(ae.EntityType)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(fmt.Sprintf("%v", ae.EntityID))
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Operation))
+	builder.WriteString(", ")
+	builder.WriteString("before=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Before))
+	builder.WriteString(", ")
+	builder.WriteString("after=")
+	builder.WriteString(fmt.Sprintf("%v", ae.After))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ae.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditEntries is a parsable slice of AuditEntry.
+type AuditEntries []*AuditEntry
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gin-crud/ent/auditentry"
+	"gin-crud/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditEntryDelete is the builder for deleting a AuditEntry entity.
+type AuditEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *AuditEntryMutation
+}
+
+// Where appends a list predicates to the AuditEntryDelete builder.
+func (aed *AuditEntryDelete) Where(ps ...predicate.AuditEntry) *AuditEntryDelete {
+	aed.mutation.Where(ps...)
+	return aed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (aed *AuditEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, aed.sqlExec, aed.mutation, aed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aed *AuditEntryDelete) ExecX(ctx context.Context) int {
+	n, err := aed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (aed *AuditEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(auditentry.Table, sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeInt))
+	if ps := aed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, aed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	aed.mutation.done = true
+	return affected, err
+}
+
+// AuditEntryDeleteOne is the builder for deleting a single AuditEntry entity.
+type AuditEntryDeleteOne struct {
+	aed *AuditEntryDelete
+}
+
+// Where appends a list predicates to the AuditEntryDelete builder.
+func (aedo *AuditEntryDeleteOne) Where(ps ...predicate.AuditEntry) *AuditEntryDeleteOne {
+	aedo.aed.mutation.Where(ps...)
+	return aedo
+}
+
+// Exec executes the deletion query.
+func (aedo *AuditEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := aedo.aed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{auditentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aedo *AuditEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := aedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
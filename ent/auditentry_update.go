@@ -0,0 +1,493 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud/ent/auditentry"
+	"gin-crud/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditEntryUpdate is the builder for updating AuditEntry entities.
+type AuditEntryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuditEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuditEntryUpdate builder.
+func (aeu *AuditEntryUpdate) Where(ps ...predicate.AuditEntry) *AuditEntryUpdate {
+	aeu.mutation.Where(ps...)
+	return aeu
+}
+
+// SetActorID sets the "actor_id" field.
+func (aeu *AuditEntryUpdate) SetActorID(i int) *AuditEntryUpdate {
+	aeu.mutation.ResetActorID()
+	aeu.mutation.SetActorID(i)
+	return aeu
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (aeu *AuditEntryUpdate) SetNillableActorID(i *int) *AuditEntryUpdate {
+	if i != nil {
+		aeu.SetActorID(*i)
+	}
+	return aeu
+}
+
+// AddActorID adds i to the "actor_id" field.
+func (aeu *AuditEntryUpdate) AddActorID(i int) *AuditEntryUpdate {
+	aeu.mutation.AddActorID(i)
+	return aeu
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (aeu *AuditEntryUpdate) ClearActorID() *AuditEntryUpdate {
+	aeu.mutation.ClearActorID()
+	return aeu
+}
+
+// SetEntityType sets the "entity_type" field.
+func (aeu *AuditEntryUpdate) SetEntityType(s string) *AuditEntryUpdate {
+	aeu.mutation.SetEntityType(s)
+	return aeu
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (aeu *AuditEntryUpdate) SetNillableEntityType(s *string) *AuditEntryUpdate {
+	if s != nil {
+		aeu.SetEntityType(*s)
+	}
+	return aeu
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aeu *AuditEntryUpdate) SetEntityID(i int64) *AuditEntryUpdate {
+	aeu.mutation.ResetEntityID()
+	aeu.mutation.SetEntityID(i)
+	return aeu
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (aeu *AuditEntryUpdate) SetNillableEntityID(i *int64) *AuditEntryUpdate {
+	if i != nil {
+		aeu.SetEntityID(*i)
+	}
+	return aeu
+}
+
+// AddEntityID adds i to the "entity_id" field.
+func (aeu *AuditEntryUpdate) AddEntityID(i int64) *AuditEntryUpdate {
+	aeu.mutation.AddEntityID(i)
+	return aeu
+}
+
+// SetOperation sets the "operation" field.
+func (aeu *AuditEntryUpdate) SetOperation(a auditentry.Operation) *AuditEntryUpdate {
+	aeu.mutation.SetOperation(a)
+	return aeu
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (aeu *AuditEntryUpdate) SetNillableOperation(a *auditentry.Operation) *AuditEntryUpdate {
+	if a != nil {
+		aeu.SetOperation(*a)
+	}
+	return aeu
+}
+
+// SetBefore sets the "before" field.
+func (aeu *AuditEntryUpdate) SetBefore(m map[string]interface{}) *AuditEntryUpdate {
+	aeu.mutation.SetBefore(m)
+	return aeu
+}
+
+// ClearBefore clears the value of the "before" field.
+func (aeu *AuditEntryUpdate) ClearBefore() *AuditEntryUpdate {
+	aeu.mutation.ClearBefore()
+	return aeu
+}
+
+// SetAfter sets the "after" field.
+func (aeu *AuditEntryUpdate) SetAfter(m map[string]interface{}) *AuditEntryUpdate {
+	aeu.mutation.SetAfter(m)
+	return aeu
+}
+
+// ClearAfter clears the value of the "after" field.
+func (aeu *AuditEntryUpdate) ClearAfter() *AuditEntryUpdate {
+	aeu.mutation.ClearAfter()
+	return aeu
+}
+
+// Mutation returns the AuditEntryMutation object of the builder.
+func (aeu *AuditEntryUpdate) Mutation() *AuditEntryMutation {
+	return aeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aeu *AuditEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aeu.sqlSave, aeu.mutation, aeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeu *AuditEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := aeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aeu *AuditEntryUpdate) Exec(ctx context.Context) error {
+	_, err := aeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeu *AuditEntryUpdate) ExecX(ctx context.Context) {
+	if err := aeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeu *AuditEntryUpdate) check() error {
+	if v, ok := aeu.mutation.EntityType(); ok {
+		if err := auditentry.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := aeu.mutation.Operation(); ok {
+		if err := auditentry.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.operation": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aeu *AuditEntryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditEntryUpdate {
+	aeu.modifiers = append(aeu.modifiers, modifiers...)
+	return aeu
+}
+
+func (aeu *AuditEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditentry.Table, auditentry.Columns, sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeInt))
+	if ps := aeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeu.mutation.ActorID(); ok {
+		_spec.SetField(auditentry.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := aeu.mutation.AddedActorID(); ok {
+		_spec.AddField(auditentry.FieldActorID, field.TypeInt, value)
+	}
+	if aeu.mutation.ActorIDCleared() {
+		_spec.ClearField(auditentry.FieldActorID, field.TypeInt)
+	}
+	if value, ok := aeu.mutation.EntityType(); ok {
+		_spec.SetField(auditentry.FieldEntityType, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.EntityID(); ok {
+		_spec.SetField(auditentry.FieldEntityID, field.TypeInt64, value)
+	}
+	if value, ok := aeu.mutation.AddedEntityID(); ok {
+		_spec.AddField(auditentry.FieldEntityID, field.TypeInt64, value)
+	}
+	if value, ok := aeu.mutation.Operation(); ok {
+		_spec.SetField(auditentry.FieldOperation, field.TypeEnum, value)
+	}
+	if value, ok := aeu.mutation.Before(); ok {
+		_spec.SetField(auditentry.FieldBefore, field.TypeJSON, value)
+	}
+	if aeu.mutation.BeforeCleared() {
+		_spec.ClearField(auditentry.FieldBefore, field.TypeJSON)
+	}
+	if value, ok := aeu.mutation.After(); ok {
+		_spec.SetField(auditentry.FieldAfter, field.TypeJSON, value)
+	}
+	if aeu.mutation.AfterCleared() {
+		_spec.ClearField(auditentry.FieldAfter, field.TypeJSON)
+	}
+	_spec.AddModifiers(aeu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, aeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aeu.mutation.done = true
+	return n, nil
+}
+
+// AuditEntryUpdateOne is the builder for updating a single AuditEntry entity.
+type AuditEntryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetActorID sets the "actor_id" field.
+func (aeuo *AuditEntryUpdateOne) SetActorID(i int) *AuditEntryUpdateOne {
+	aeuo.mutation.ResetActorID()
+	aeuo.mutation.SetActorID(i)
+	return aeuo
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (aeuo *AuditEntryUpdateOne) SetNillableActorID(i *int) *AuditEntryUpdateOne {
+	if i != nil {
+		aeuo.SetActorID(*i)
+	}
+	return aeuo
+}
+
+// AddActorID adds i to the "actor_id" field.
+func (aeuo *AuditEntryUpdateOne) AddActorID(i int) *AuditEntryUpdateOne {
+	aeuo.mutation.AddActorID(i)
+	return aeuo
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (aeuo *AuditEntryUpdateOne) ClearActorID() *AuditEntryUpdateOne {
+	aeuo.mutation.ClearActorID()
+	return aeuo
+}
+
+// SetEntityType sets the "entity_type" field.
+func (aeuo *AuditEntryUpdateOne) SetEntityType(s string) *AuditEntryUpdateOne {
+	aeuo.mutation.SetEntityType(s)
+	return aeuo
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (aeuo *AuditEntryUpdateOne) SetNillableEntityType(s *string) *AuditEntryUpdateOne {
+	if s != nil {
+		aeuo.SetEntityType(*s)
+	}
+	return aeuo
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aeuo *AuditEntryUpdateOne) SetEntityID(i int64) *AuditEntryUpdateOne {
+	aeuo.mutation.ResetEntityID()
+	aeuo.mutation.SetEntityID(i)
+	return aeuo
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (aeuo *AuditEntryUpdateOne) SetNillableEntityID(i *int64) *AuditEntryUpdateOne {
+	if i != nil {
+		aeuo.SetEntityID(*i)
+	}
+	return aeuo
+}
+
+// AddEntityID adds i to the "entity_id" field.
+func (aeuo *AuditEntryUpdateOne) AddEntityID(i int64) *AuditEntryUpdateOne {
+	aeuo.mutation.AddEntityID(i)
+	return aeuo
+}
+
+// SetOperation sets the "operation" field.
+func (aeuo *AuditEntryUpdateOne) SetOperation(a auditentry.Operation) *AuditEntryUpdateOne {
+	aeuo.mutation.SetOperation(a)
+	return aeuo
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (aeuo *AuditEntryUpdateOne) SetNillableOperation(a *auditentry.Operation) *AuditEntryUpdateOne {
+	if a != nil {
+		aeuo.SetOperation(*a)
+	}
+	return aeuo
+}
+
+// SetBefore sets the "before" field.
+func (aeuo *AuditEntryUpdateOne) SetBefore(m map[string]interface{}) *AuditEntryUpdateOne {
+	aeuo.mutation.SetBefore(m)
+	return aeuo
+}
+
+// ClearBefore clears the value of the "before" field.
+func (aeuo *AuditEntryUpdateOne) ClearBefore() *AuditEntryUpdateOne {
+	aeuo.mutation.ClearBefore()
+	return aeuo
+}
+
+// SetAfter sets the "after" field.
+func (aeuo *AuditEntryUpdateOne) SetAfter(m map[string]interface{}) *AuditEntryUpdateOne {
+	aeuo.mutation.SetAfter(m)
+	return aeuo
+}
+
+// ClearAfter clears the value of the "after" field.
+func (aeuo *AuditEntryUpdateOne) ClearAfter() *AuditEntryUpdateOne {
+	aeuo.mutation.ClearAfter()
+	return aeuo
+}
+
+// Mutation returns the AuditEntryMutation object of the builder.
+func (aeuo *AuditEntryUpdateOne) Mutation() *AuditEntryMutation {
+	return aeuo.mutation
+}
+
+// Where appends a list predicates to the AuditEntryUpdate builder.
+func (aeuo *AuditEntryUpdateOne) Where(ps ...predicate.AuditEntry) *AuditEntryUpdateOne {
+	aeuo.mutation.Where(ps...)
+	return aeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aeuo *AuditEntryUpdateOne) Select(field string, fields ...string) *AuditEntryUpdateOne {
+	aeuo.fields = append([]string{field}, fields...)
+	return aeuo
+}
+
+// Save executes the query and returns the updated AuditEntry entity.
+func (aeuo *AuditEntryUpdateOne) Save(ctx context.Context) (*AuditEntry, error) {
+	return withHooks(ctx, aeuo.sqlSave, aeuo.mutation, aeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeuo *AuditEntryUpdateOne) SaveX(ctx context.Context) *AuditEntry {
+	node, err := aeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aeuo *AuditEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := aeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeuo *AuditEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := aeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeuo *AuditEntryUpdateOne) check() error {
+	if v, ok := aeuo.mutation.EntityType(); ok {
+		if err := auditentry.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := aeuo.mutation.Operation(); ok {
+		if err := auditentry.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.operation": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aeuo *AuditEntryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditEntryUpdateOne {
+	aeuo.modifiers = append(aeuo.modifiers, modifiers...)
+	return aeuo
+}
+
+func (aeuo *AuditEntryUpdateOne) sqlSave(ctx context.Context) (_node *AuditEntry, err error) {
+	if err := aeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditentry.Table, auditentry.Columns, sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeInt))
+	id, ok := aeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditentry.FieldID)
+		for _, f := range fields {
+			if !auditentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeuo.mutation.ActorID(); ok {
+		_spec.SetField(auditentry.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := aeuo.mutation.AddedActorID(); ok {
+		_spec.AddField(auditentry.FieldActorID, field.TypeInt, value)
+	}
+	if aeuo.mutation.ActorIDCleared() {
+		_spec.ClearField(auditentry.FieldActorID, field.TypeInt)
+	}
+	if value, ok := aeuo.mutation.EntityType(); ok {
+		_spec.SetField(auditentry.FieldEntityType, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.EntityID(); ok {
+		_spec.SetField(auditentry.FieldEntityID, field.TypeInt64, value)
+	}
+	if value, ok := aeuo.mutation.AddedEntityID(); ok {
+		_spec.AddField(auditentry.FieldEntityID, field.TypeInt64, value)
+	}
+	if value, ok := aeuo.mutation.Operation(); ok {
+		_spec.SetField(auditentry.FieldOperation, field.TypeEnum, value)
+	}
+	if value, ok := aeuo.mutation.Before(); ok {
+		_spec.SetField(auditentry.FieldBefore, field.TypeJSON, value)
+	}
+	if aeuo.mutation.BeforeCleared() {
+		_spec.ClearField(auditentry.FieldBefore, field.TypeJSON)
+	}
+	if value, ok := aeuo.mutation.After(); ok {
+		_spec.SetField(auditentry.FieldAfter, field.TypeJSON, value)
+	}
+	if aeuo.mutation.AfterCleared() {
+		_spec.ClearField(auditentry.FieldAfter, field.TypeJSON)
+	}
+	_spec.AddModifiers(aeuo.modifiers...)
+	_node = &AuditEntry{config: aeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aeuo.mutation.done = true
+	return _node, nil
+}
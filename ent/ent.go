@@ -6,7 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"gin-crud/ent/auditentry"
+	"gin-crud/ent/category"
 	"gin-crud/ent/item"
+	"gin-crud/ent/itemimage"
+	"gin-crud/ent/itemrevision"
+	"gin-crud/ent/pricechange"
+	"gin-crud/ent/refreshtoken"
+	"gin-crud/ent/revokedtoken"
+	"gin-crud/ent/tag"
 	"gin-crud/ent/user"
 	"reflect"
 	"sync"
@@ -74,8 +82,16 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			item.Table: item.ValidColumn,
-			user.Table: user.ValidColumn,
+			auditentry.Table:   auditentry.ValidColumn,
+			category.Table:     category.ValidColumn,
+			item.Table:         item.ValidColumn,
+			itemimage.Table:    itemimage.ValidColumn,
+			itemrevision.Table: itemrevision.ValidColumn,
+			pricechange.Table:  pricechange.ValidColumn,
+			refreshtoken.Table: refreshtoken.ValidColumn,
+			revokedtoken.Table: revokedtoken.ValidColumn,
+			tag.Table:          tag.ValidColumn,
+			user.Table:         user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -5,7 +5,9 @@ package ent
 import (
 	"fmt"
 	"gin-crud/ent/item"
+	"gin-crud/ent/user"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -16,24 +18,114 @@ type Item struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int64 `json:"id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Price holds the value of the "price" field.
 	Price int `json:"price,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
 	// Description holds the value of the "description" field.
-	Description  string `json:"description,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Stock holds the value of the "stock" field.
+	Stock int `json:"stock,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID int `json:"owner_id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemQuery when eager-loading is set.
+	Edges        ItemEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// ItemEdges holds the relations/edges for other nodes in the graph.
+type ItemEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*ItemRevision `json:"revisions,omitempty"`
+	// Images holds the value of the images edge.
+	Images []*ItemImage `json:"images,omitempty"`
+	// PriceChanges holds the value of the price_changes edge.
+	PriceChanges []*PriceChange `json:"price_changes,omitempty"`
+	// Categories holds the value of the categories edge.
+	Categories []*Category `json:"categories,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) RevisionsOrErr() ([]*ItemRevision, error) {
+	if e.loadedTypes[1] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// ImagesOrErr returns the Images value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) ImagesOrErr() ([]*ItemImage, error) {
+	if e.loadedTypes[2] {
+		return e.Images, nil
+	}
+	return nil, &NotLoadedError{edge: "images"}
+}
+
+// PriceChangesOrErr returns the PriceChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) PriceChangesOrErr() ([]*PriceChange, error) {
+	if e.loadedTypes[3] {
+		return e.PriceChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "price_changes"}
+}
+
+// CategoriesOrErr returns the Categories value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) CategoriesOrErr() ([]*Category, error) {
+	if e.loadedTypes[4] {
+		return e.Categories, nil
+	}
+	return nil, &NotLoadedError{edge: "categories"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[5] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Item) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case item.FieldID, item.FieldPrice:
+		case item.FieldID, item.FieldPrice, item.FieldStock, item.FieldOwnerID, item.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case item.FieldName, item.FieldDescription:
+		case item.FieldName, item.FieldCurrency, item.FieldDescription:
 			values[i] = new(sql.NullString)
+		case item.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -55,6 +147,13 @@ func (i *Item) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			i.ID = int64(value.Int64)
+		case item.FieldDeletedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[j])
+			} else if value.Valid {
+				i.DeletedAt = new(time.Time)
+				*i.DeletedAt = value.Time
+			}
 		case item.FieldName:
 			if value, ok := values[j].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[j])
@@ -67,12 +166,36 @@ func (i *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.Price = int(value.Int64)
 			}
+		case item.FieldCurrency:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[j])
+			} else if value.Valid {
+				i.Currency = value.String
+			}
 		case item.FieldDescription:
 			if value, ok := values[j].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[j])
 			} else if value.Valid {
 				i.Description = value.String
 			}
+		case item.FieldStock:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stock", values[j])
+			} else if value.Valid {
+				i.Stock = int(value.Int64)
+			}
+		case item.FieldOwnerID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[j])
+			} else if value.Valid {
+				i.OwnerID = int(value.Int64)
+			}
+		case item.FieldVersion:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[j])
+			} else if value.Valid {
+				i.Version = int(value.Int64)
+			}
 		default:
 			i.selectValues.Set(columns[j], values[j])
 		}
@@ -86,6 +209,36 @@ func (i *Item) Value(name string) (ent.Value, error) {
 	return i.selectValues.Get(name)
 }
 
+// QueryOwner queries the "owner" edge of the Item entity.
+func (i *Item) QueryOwner() *UserQuery {
+	return NewItemClient(i.config).QueryOwner(i)
+}
+
+// QueryRevisions queries the "revisions" edge of the Item entity.
+func (i *Item) QueryRevisions() *ItemRevisionQuery {
+	return NewItemClient(i.config).QueryRevisions(i)
+}
+
+// QueryImages queries the "images" edge of the Item entity.
+func (i *Item) QueryImages() *ItemImageQuery {
+	return NewItemClient(i.config).QueryImages(i)
+}
+
+// QueryPriceChanges queries the "price_changes" edge of the Item entity.
+func (i *Item) QueryPriceChanges() *PriceChangeQuery {
+	return NewItemClient(i.config).QueryPriceChanges(i)
+}
+
+// QueryCategories queries the "categories" edge of the Item entity.
+func (i *Item) QueryCategories() *CategoryQuery {
+	return NewItemClient(i.config).QueryCategories(i)
+}
+
+// QueryTags queries the "tags" edge of the Item entity.
+func (i *Item) QueryTags() *TagQuery {
+	return NewItemClient(i.config).QueryTags(i)
+}
+
 // Update returns a builder for updating this Item.
 // Note that you need to call Item.Unwrap() before calling this method if this Item
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -109,14 +262,31 @@ func (i *Item) String() string {
 	var builder strings.Builder
 	builder.WriteString("Item(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	if v := i.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(i.Name)
 	builder.WriteString(", ")
 	builder.WriteString("price=")
 	builder.WriteString(fmt.Sprintf("%v", i.Price))
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(i.Currency)
+	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(i.Description)
+	builder.WriteString(", ")
+	builder.WriteString("stock=")
+	builder.WriteString(fmt.Sprintf("%v", i.Stock))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", i.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -6,7 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"gin-crud/ent/category"
 	"gin-crud/ent/item"
+	"gin-crud/ent/itemimage"
+	"gin-crud/ent/itemrevision"
+	"gin-crud/ent/pricechange"
+	"gin-crud/ent/tag"
+	"gin-crud/ent/user"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -19,6 +26,20 @@ type ItemCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ic *ItemCreate) SetDeletedAt(t time.Time) *ItemCreate {
+	ic.mutation.SetDeletedAt(t)
+	return ic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableDeletedAt(t *time.Time) *ItemCreate {
+	if t != nil {
+		ic.SetDeletedAt(*t)
+	}
+	return ic
+}
+
 // SetName sets the "name" field.
 func (ic *ItemCreate) SetName(s string) *ItemCreate {
 	ic.mutation.SetName(s)
@@ -31,6 +52,20 @@ func (ic *ItemCreate) SetPrice(i int) *ItemCreate {
 	return ic
 }
 
+// SetCurrency sets the "currency" field.
+func (ic *ItemCreate) SetCurrency(s string) *ItemCreate {
+	ic.mutation.SetCurrency(s)
+	return ic
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableCurrency(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetCurrency(*s)
+	}
+	return ic
+}
+
 // SetDescription sets the "description" field.
 func (ic *ItemCreate) SetDescription(s string) *ItemCreate {
 	ic.mutation.SetDescription(s)
@@ -45,12 +80,134 @@ func (ic *ItemCreate) SetNillableDescription(s *string) *ItemCreate {
 	return ic
 }
 
+// SetStock sets the "stock" field.
+func (ic *ItemCreate) SetStock(i int) *ItemCreate {
+	ic.mutation.SetStock(i)
+	return ic
+}
+
+// SetNillableStock sets the "stock" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableStock(i *int) *ItemCreate {
+	if i != nil {
+		ic.SetStock(*i)
+	}
+	return ic
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (ic *ItemCreate) SetOwnerID(i int) *ItemCreate {
+	ic.mutation.SetOwnerID(i)
+	return ic
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableOwnerID(i *int) *ItemCreate {
+	if i != nil {
+		ic.SetOwnerID(*i)
+	}
+	return ic
+}
+
+// SetVersion sets the "version" field.
+func (ic *ItemCreate) SetVersion(i int) *ItemCreate {
+	ic.mutation.SetVersion(i)
+	return ic
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableVersion(i *int) *ItemCreate {
+	if i != nil {
+		ic.SetVersion(*i)
+	}
+	return ic
+}
+
 // SetID sets the "id" field.
 func (ic *ItemCreate) SetID(i int64) *ItemCreate {
 	ic.mutation.SetID(i)
 	return ic
 }
 
+// SetOwner sets the "owner" edge to the User entity.
+func (ic *ItemCreate) SetOwner(u *User) *ItemCreate {
+	return ic.SetOwnerID(u.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ItemRevision entity by IDs.
+func (ic *ItemCreate) AddRevisionIDs(ids ...int) *ItemCreate {
+	ic.mutation.AddRevisionIDs(ids...)
+	return ic
+}
+
+// AddRevisions adds the "revisions" edges to the ItemRevision entity.
+func (ic *ItemCreate) AddRevisions(i ...*ItemRevision) *ItemCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddRevisionIDs(ids...)
+}
+
+// AddImageIDs adds the "images" edge to the ItemImage entity by IDs.
+func (ic *ItemCreate) AddImageIDs(ids ...int) *ItemCreate {
+	ic.mutation.AddImageIDs(ids...)
+	return ic
+}
+
+// AddImages adds the "images" edges to the ItemImage entity.
+func (ic *ItemCreate) AddImages(i ...*ItemImage) *ItemCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddImageIDs(ids...)
+}
+
+// AddPriceChangeIDs adds the "price_changes" edge to the PriceChange entity by IDs.
+func (ic *ItemCreate) AddPriceChangeIDs(ids ...int) *ItemCreate {
+	ic.mutation.AddPriceChangeIDs(ids...)
+	return ic
+}
+
+// AddPriceChanges adds the "price_changes" edges to the PriceChange entity.
+func (ic *ItemCreate) AddPriceChanges(p ...*PriceChange) *ItemCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ic.AddPriceChangeIDs(ids...)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (ic *ItemCreate) AddCategoryIDs(ids ...int) *ItemCreate {
+	ic.mutation.AddCategoryIDs(ids...)
+	return ic
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (ic *ItemCreate) AddCategories(c ...*Category) *ItemCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ic.AddCategoryIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (ic *ItemCreate) AddTagIDs(ids ...int) *ItemCreate {
+	ic.mutation.AddTagIDs(ids...)
+	return ic
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (ic *ItemCreate) AddTags(t ...*Tag) *ItemCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ic.AddTagIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (ic *ItemCreate) Mutation() *ItemMutation {
 	return ic.mutation
@@ -58,6 +215,9 @@ func (ic *ItemCreate) Mutation() *ItemMutation {
 
 // Save creates the Item in the database.
 func (ic *ItemCreate) Save(ctx context.Context) (*Item, error) {
+	if err := ic.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
 }
 
@@ -83,6 +243,23 @@ func (ic *ItemCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (ic *ItemCreate) defaults() error {
+	if _, ok := ic.mutation.Currency(); !ok {
+		v := item.DefaultCurrency
+		ic.mutation.SetCurrency(v)
+	}
+	if _, ok := ic.mutation.Stock(); !ok {
+		v := item.DefaultStock
+		ic.mutation.SetStock(v)
+	}
+	if _, ok := ic.mutation.Version(); !ok {
+		v := item.DefaultVersion
+		ic.mutation.SetVersion(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (ic *ItemCreate) check() error {
 	if _, ok := ic.mutation.Name(); !ok {
@@ -96,6 +273,25 @@ func (ic *ItemCreate) check() error {
 	if _, ok := ic.mutation.Price(); !ok {
 		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Item.price"`)}
 	}
+	if _, ok := ic.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Item.currency"`)}
+	}
+	if v, ok := ic.mutation.Currency(); ok {
+		if err := item.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Item.currency": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Stock(); !ok {
+		return &ValidationError{Name: "stock", err: errors.New(`ent: missing required field "Item.stock"`)}
+	}
+	if v, ok := ic.mutation.Stock(); ok {
+		if err := item.StockValidator(v); err != nil {
+			return &ValidationError{Name: "stock", err: fmt.Errorf(`ent: validator failed for field "Item.stock": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Item.version"`)}
+	}
 	return nil
 }
 
@@ -128,6 +324,10 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := ic.mutation.DeletedAt(); ok {
+		_spec.SetField(item.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := ic.mutation.Name(); ok {
 		_spec.SetField(item.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -136,10 +336,119 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldPrice, field.TypeInt, value)
 		_node.Price = value
 	}
+	if value, ok := ic.mutation.Currency(); ok {
+		_spec.SetField(item.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
 	if value, ok := ic.mutation.Description(); ok {
 		_spec.SetField(item.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := ic.mutation.Stock(); ok {
+		_spec.SetField(item.FieldStock, field.TypeInt, value)
+		_node.Stock = value
+	}
+	if value, ok := ic.mutation.Version(); ok {
+		_spec.SetField(item.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if nodes := ic.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.OwnerTable,
+			Columns: []string{item.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.ImagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ImagesTable,
+			Columns: []string{item.ImagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.PriceChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceChangesTable,
+			Columns: []string{item.PriceChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -161,6 +470,7 @@ func (icb *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
 	for i := range icb.builders {
 		func(i int, root context.Context) {
 			builder := icb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*ItemMutation)
 				if !ok {
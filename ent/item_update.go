@@ -6,8 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"gin-crud/ent/category"
 	"gin-crud/ent/item"
+	"gin-crud/ent/itemimage"
+	"gin-crud/ent/itemrevision"
 	"gin-crud/ent/predicate"
+	"gin-crud/ent/pricechange"
+	"gin-crud/ent/tag"
+	"gin-crud/ent/user"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -17,8 +24,9 @@ import (
 // ItemUpdate is the builder for updating Item entities.
 type ItemUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ItemMutation
+	hooks     []Hook
+	mutation  *ItemMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ItemUpdate builder.
@@ -27,6 +35,26 @@ func (iu *ItemUpdate) Where(ps ...predicate.Item) *ItemUpdate {
 	return iu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (iu *ItemUpdate) SetDeletedAt(t time.Time) *ItemUpdate {
+	iu.mutation.SetDeletedAt(t)
+	return iu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableDeletedAt(t *time.Time) *ItemUpdate {
+	if t != nil {
+		iu.SetDeletedAt(*t)
+	}
+	return iu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iu *ItemUpdate) ClearDeletedAt() *ItemUpdate {
+	iu.mutation.ClearDeletedAt()
+	return iu
+}
+
 // SetName sets the "name" field.
 func (iu *ItemUpdate) SetName(s string) *ItemUpdate {
 	iu.mutation.SetName(s)
@@ -62,6 +90,20 @@ func (iu *ItemUpdate) AddPrice(i int) *ItemUpdate {
 	return iu
 }
 
+// SetCurrency sets the "currency" field.
+func (iu *ItemUpdate) SetCurrency(s string) *ItemUpdate {
+	iu.mutation.SetCurrency(s)
+	return iu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableCurrency(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetCurrency(*s)
+	}
+	return iu
+}
+
 // SetDescription sets the "description" field.
 func (iu *ItemUpdate) SetDescription(s string) *ItemUpdate {
 	iu.mutation.SetDescription(s)
@@ -82,11 +124,264 @@ func (iu *ItemUpdate) ClearDescription() *ItemUpdate {
 	return iu
 }
 
+// SetStock sets the "stock" field.
+func (iu *ItemUpdate) SetStock(i int) *ItemUpdate {
+	iu.mutation.ResetStock()
+	iu.mutation.SetStock(i)
+	return iu
+}
+
+// SetNillableStock sets the "stock" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableStock(i *int) *ItemUpdate {
+	if i != nil {
+		iu.SetStock(*i)
+	}
+	return iu
+}
+
+// AddStock adds i to the "stock" field.
+func (iu *ItemUpdate) AddStock(i int) *ItemUpdate {
+	iu.mutation.AddStock(i)
+	return iu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (iu *ItemUpdate) SetOwnerID(i int) *ItemUpdate {
+	iu.mutation.SetOwnerID(i)
+	return iu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableOwnerID(i *int) *ItemUpdate {
+	if i != nil {
+		iu.SetOwnerID(*i)
+	}
+	return iu
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (iu *ItemUpdate) ClearOwnerID() *ItemUpdate {
+	iu.mutation.ClearOwnerID()
+	return iu
+}
+
+// SetVersion sets the "version" field.
+func (iu *ItemUpdate) SetVersion(i int) *ItemUpdate {
+	iu.mutation.ResetVersion()
+	iu.mutation.SetVersion(i)
+	return iu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableVersion(i *int) *ItemUpdate {
+	if i != nil {
+		iu.SetVersion(*i)
+	}
+	return iu
+}
+
+// AddVersion adds i to the "version" field.
+func (iu *ItemUpdate) AddVersion(i int) *ItemUpdate {
+	iu.mutation.AddVersion(i)
+	return iu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (iu *ItemUpdate) SetOwner(u *User) *ItemUpdate {
+	return iu.SetOwnerID(u.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ItemRevision entity by IDs.
+func (iu *ItemUpdate) AddRevisionIDs(ids ...int) *ItemUpdate {
+	iu.mutation.AddRevisionIDs(ids...)
+	return iu
+}
+
+// AddRevisions adds the "revisions" edges to the ItemRevision entity.
+func (iu *ItemUpdate) AddRevisions(i ...*ItemRevision) *ItemUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddRevisionIDs(ids...)
+}
+
+// AddImageIDs adds the "images" edge to the ItemImage entity by IDs.
+func (iu *ItemUpdate) AddImageIDs(ids ...int) *ItemUpdate {
+	iu.mutation.AddImageIDs(ids...)
+	return iu
+}
+
+// AddImages adds the "images" edges to the ItemImage entity.
+func (iu *ItemUpdate) AddImages(i ...*ItemImage) *ItemUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddImageIDs(ids...)
+}
+
+// AddPriceChangeIDs adds the "price_changes" edge to the PriceChange entity by IDs.
+func (iu *ItemUpdate) AddPriceChangeIDs(ids ...int) *ItemUpdate {
+	iu.mutation.AddPriceChangeIDs(ids...)
+	return iu
+}
+
+// AddPriceChanges adds the "price_changes" edges to the PriceChange entity.
+func (iu *ItemUpdate) AddPriceChanges(p ...*PriceChange) *ItemUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return iu.AddPriceChangeIDs(ids...)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (iu *ItemUpdate) AddCategoryIDs(ids ...int) *ItemUpdate {
+	iu.mutation.AddCategoryIDs(ids...)
+	return iu
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (iu *ItemUpdate) AddCategories(c ...*Category) *ItemUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iu.AddCategoryIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (iu *ItemUpdate) AddTagIDs(ids ...int) *ItemUpdate {
+	iu.mutation.AddTagIDs(ids...)
+	return iu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (iu *ItemUpdate) AddTags(t ...*Tag) *ItemUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return iu.AddTagIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iu *ItemUpdate) Mutation() *ItemMutation {
 	return iu.mutation
 }
 
+// ClearOwner clears the "owner" edge to the User entity.
+func (iu *ItemUpdate) ClearOwner() *ItemUpdate {
+	iu.mutation.ClearOwner()
+	return iu
+}
+
+// ClearRevisions clears all "revisions" edges to the ItemRevision entity.
+func (iu *ItemUpdate) ClearRevisions() *ItemUpdate {
+	iu.mutation.ClearRevisions()
+	return iu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to ItemRevision entities by IDs.
+func (iu *ItemUpdate) RemoveRevisionIDs(ids ...int) *ItemUpdate {
+	iu.mutation.RemoveRevisionIDs(ids...)
+	return iu
+}
+
+// RemoveRevisions removes "revisions" edges to ItemRevision entities.
+func (iu *ItemUpdate) RemoveRevisions(i ...*ItemRevision) *ItemUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveRevisionIDs(ids...)
+}
+
+// ClearImages clears all "images" edges to the ItemImage entity.
+func (iu *ItemUpdate) ClearImages() *ItemUpdate {
+	iu.mutation.ClearImages()
+	return iu
+}
+
+// RemoveImageIDs removes the "images" edge to ItemImage entities by IDs.
+func (iu *ItemUpdate) RemoveImageIDs(ids ...int) *ItemUpdate {
+	iu.mutation.RemoveImageIDs(ids...)
+	return iu
+}
+
+// RemoveImages removes "images" edges to ItemImage entities.
+func (iu *ItemUpdate) RemoveImages(i ...*ItemImage) *ItemUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveImageIDs(ids...)
+}
+
+// ClearPriceChanges clears all "price_changes" edges to the PriceChange entity.
+func (iu *ItemUpdate) ClearPriceChanges() *ItemUpdate {
+	iu.mutation.ClearPriceChanges()
+	return iu
+}
+
+// RemovePriceChangeIDs removes the "price_changes" edge to PriceChange entities by IDs.
+func (iu *ItemUpdate) RemovePriceChangeIDs(ids ...int) *ItemUpdate {
+	iu.mutation.RemovePriceChangeIDs(ids...)
+	return iu
+}
+
+// RemovePriceChanges removes "price_changes" edges to PriceChange entities.
+func (iu *ItemUpdate) RemovePriceChanges(p ...*PriceChange) *ItemUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return iu.RemovePriceChangeIDs(ids...)
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (iu *ItemUpdate) ClearCategories() *ItemUpdate {
+	iu.mutation.ClearCategories()
+	return iu
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (iu *ItemUpdate) RemoveCategoryIDs(ids ...int) *ItemUpdate {
+	iu.mutation.RemoveCategoryIDs(ids...)
+	return iu
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (iu *ItemUpdate) RemoveCategories(c ...*Category) *ItemUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iu.RemoveCategoryIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (iu *ItemUpdate) ClearTags() *ItemUpdate {
+	iu.mutation.ClearTags()
+	return iu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (iu *ItemUpdate) RemoveTagIDs(ids ...int) *ItemUpdate {
+	iu.mutation.RemoveTagIDs(ids...)
+	return iu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (iu *ItemUpdate) RemoveTags(t ...*Tag) *ItemUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return iu.RemoveTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (iu *ItemUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
@@ -121,9 +416,25 @@ func (iu *ItemUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Item.name": %w`, err)}
 		}
 	}
+	if v, ok := iu.mutation.Currency(); ok {
+		if err := item.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Item.currency": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Stock(); ok {
+		if err := item.StockValidator(v); err != nil {
+			return &ValidationError{Name: "stock", err: fmt.Errorf(`ent: validator failed for field "Item.stock": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iu *ItemUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemUpdate {
+	iu.modifiers = append(iu.modifiers, modifiers...)
+	return iu
+}
+
 func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := iu.check(); err != nil {
 		return n, err
@@ -136,6 +447,12 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := iu.mutation.DeletedAt(); ok {
+		_spec.SetField(item.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iu.mutation.DeletedAtCleared() {
+		_spec.ClearField(item.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := iu.mutation.Name(); ok {
 		_spec.SetField(item.FieldName, field.TypeString, value)
 	}
@@ -145,12 +462,282 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.AddedPrice(); ok {
 		_spec.AddField(item.FieldPrice, field.TypeInt, value)
 	}
+	if value, ok := iu.mutation.Currency(); ok {
+		_spec.SetField(item.FieldCurrency, field.TypeString, value)
+	}
 	if value, ok := iu.mutation.Description(); ok {
 		_spec.SetField(item.FieldDescription, field.TypeString, value)
 	}
 	if iu.mutation.DescriptionCleared() {
 		_spec.ClearField(item.FieldDescription, field.TypeString)
 	}
+	if value, ok := iu.mutation.Stock(); ok {
+		_spec.SetField(item.FieldStock, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedStock(); ok {
+		_spec.AddField(item.FieldStock, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.Version(); ok {
+		_spec.SetField(item.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedVersion(); ok {
+		_spec.AddField(item.FieldVersion, field.TypeInt, value)
+	}
+	if iu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.OwnerTable,
+			Columns: []string{item.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.OwnerTable,
+			Columns: []string{item.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !iu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.ImagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ImagesTable,
+			Columns: []string{item.ImagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedImagesIDs(); len(nodes) > 0 && !iu.mutation.ImagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ImagesTable,
+			Columns: []string{item.ImagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.ImagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ImagesTable,
+			Columns: []string{item.ImagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.PriceChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceChangesTable,
+			Columns: []string{item.PriceChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedPriceChangesIDs(); len(nodes) > 0 && !iu.mutation.PriceChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceChangesTable,
+			Columns: []string{item.PriceChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.PriceChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceChangesTable,
+			Columns: []string{item.PriceChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !iu.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !iu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{item.Label}
@@ -166,9 +753,30 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // ItemUpdateOne is the builder for updating a single Item entity.
 type ItemUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ItemMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (iuo *ItemUpdateOne) SetDeletedAt(t time.Time) *ItemUpdateOne {
+	iuo.mutation.SetDeletedAt(t)
+	return iuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableDeletedAt(t *time.Time) *ItemUpdateOne {
+	if t != nil {
+		iuo.SetDeletedAt(*t)
+	}
+	return iuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iuo *ItemUpdateOne) ClearDeletedAt() *ItemUpdateOne {
+	iuo.mutation.ClearDeletedAt()
+	return iuo
 }
 
 // SetName sets the "name" field.
@@ -206,6 +814,20 @@ func (iuo *ItemUpdateOne) AddPrice(i int) *ItemUpdateOne {
 	return iuo
 }
 
+// SetCurrency sets the "currency" field.
+func (iuo *ItemUpdateOne) SetCurrency(s string) *ItemUpdateOne {
+	iuo.mutation.SetCurrency(s)
+	return iuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableCurrency(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetCurrency(*s)
+	}
+	return iuo
+}
+
 // SetDescription sets the "description" field.
 func (iuo *ItemUpdateOne) SetDescription(s string) *ItemUpdateOne {
 	iuo.mutation.SetDescription(s)
@@ -226,11 +848,264 @@ func (iuo *ItemUpdateOne) ClearDescription() *ItemUpdateOne {
 	return iuo
 }
 
+// SetStock sets the "stock" field.
+func (iuo *ItemUpdateOne) SetStock(i int) *ItemUpdateOne {
+	iuo.mutation.ResetStock()
+	iuo.mutation.SetStock(i)
+	return iuo
+}
+
+// SetNillableStock sets the "stock" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableStock(i *int) *ItemUpdateOne {
+	if i != nil {
+		iuo.SetStock(*i)
+	}
+	return iuo
+}
+
+// AddStock adds i to the "stock" field.
+func (iuo *ItemUpdateOne) AddStock(i int) *ItemUpdateOne {
+	iuo.mutation.AddStock(i)
+	return iuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (iuo *ItemUpdateOne) SetOwnerID(i int) *ItemUpdateOne {
+	iuo.mutation.SetOwnerID(i)
+	return iuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableOwnerID(i *int) *ItemUpdateOne {
+	if i != nil {
+		iuo.SetOwnerID(*i)
+	}
+	return iuo
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (iuo *ItemUpdateOne) ClearOwnerID() *ItemUpdateOne {
+	iuo.mutation.ClearOwnerID()
+	return iuo
+}
+
+// SetVersion sets the "version" field.
+func (iuo *ItemUpdateOne) SetVersion(i int) *ItemUpdateOne {
+	iuo.mutation.ResetVersion()
+	iuo.mutation.SetVersion(i)
+	return iuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableVersion(i *int) *ItemUpdateOne {
+	if i != nil {
+		iuo.SetVersion(*i)
+	}
+	return iuo
+}
+
+// AddVersion adds i to the "version" field.
+func (iuo *ItemUpdateOne) AddVersion(i int) *ItemUpdateOne {
+	iuo.mutation.AddVersion(i)
+	return iuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (iuo *ItemUpdateOne) SetOwner(u *User) *ItemUpdateOne {
+	return iuo.SetOwnerID(u.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ItemRevision entity by IDs.
+func (iuo *ItemUpdateOne) AddRevisionIDs(ids ...int) *ItemUpdateOne {
+	iuo.mutation.AddRevisionIDs(ids...)
+	return iuo
+}
+
+// AddRevisions adds the "revisions" edges to the ItemRevision entity.
+func (iuo *ItemUpdateOne) AddRevisions(i ...*ItemRevision) *ItemUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddRevisionIDs(ids...)
+}
+
+// AddImageIDs adds the "images" edge to the ItemImage entity by IDs.
+func (iuo *ItemUpdateOne) AddImageIDs(ids ...int) *ItemUpdateOne {
+	iuo.mutation.AddImageIDs(ids...)
+	return iuo
+}
+
+// AddImages adds the "images" edges to the ItemImage entity.
+func (iuo *ItemUpdateOne) AddImages(i ...*ItemImage) *ItemUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddImageIDs(ids...)
+}
+
+// AddPriceChangeIDs adds the "price_changes" edge to the PriceChange entity by IDs.
+func (iuo *ItemUpdateOne) AddPriceChangeIDs(ids ...int) *ItemUpdateOne {
+	iuo.mutation.AddPriceChangeIDs(ids...)
+	return iuo
+}
+
+// AddPriceChanges adds the "price_changes" edges to the PriceChange entity.
+func (iuo *ItemUpdateOne) AddPriceChanges(p ...*PriceChange) *ItemUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return iuo.AddPriceChangeIDs(ids...)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (iuo *ItemUpdateOne) AddCategoryIDs(ids ...int) *ItemUpdateOne {
+	iuo.mutation.AddCategoryIDs(ids...)
+	return iuo
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (iuo *ItemUpdateOne) AddCategories(c ...*Category) *ItemUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iuo.AddCategoryIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (iuo *ItemUpdateOne) AddTagIDs(ids ...int) *ItemUpdateOne {
+	iuo.mutation.AddTagIDs(ids...)
+	return iuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (iuo *ItemUpdateOne) AddTags(t ...*Tag) *ItemUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return iuo.AddTagIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iuo *ItemUpdateOne) Mutation() *ItemMutation {
 	return iuo.mutation
 }
 
+// ClearOwner clears the "owner" edge to the User entity.
+func (iuo *ItemUpdateOne) ClearOwner() *ItemUpdateOne {
+	iuo.mutation.ClearOwner()
+	return iuo
+}
+
+// ClearRevisions clears all "revisions" edges to the ItemRevision entity.
+func (iuo *ItemUpdateOne) ClearRevisions() *ItemUpdateOne {
+	iuo.mutation.ClearRevisions()
+	return iuo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to ItemRevision entities by IDs.
+func (iuo *ItemUpdateOne) RemoveRevisionIDs(ids ...int) *ItemUpdateOne {
+	iuo.mutation.RemoveRevisionIDs(ids...)
+	return iuo
+}
+
+// RemoveRevisions removes "revisions" edges to ItemRevision entities.
+func (iuo *ItemUpdateOne) RemoveRevisions(i ...*ItemRevision) *ItemUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveRevisionIDs(ids...)
+}
+
+// ClearImages clears all "images" edges to the ItemImage entity.
+func (iuo *ItemUpdateOne) ClearImages() *ItemUpdateOne {
+	iuo.mutation.ClearImages()
+	return iuo
+}
+
+// RemoveImageIDs removes the "images" edge to ItemImage entities by IDs.
+func (iuo *ItemUpdateOne) RemoveImageIDs(ids ...int) *ItemUpdateOne {
+	iuo.mutation.RemoveImageIDs(ids...)
+	return iuo
+}
+
+// RemoveImages removes "images" edges to ItemImage entities.
+func (iuo *ItemUpdateOne) RemoveImages(i ...*ItemImage) *ItemUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveImageIDs(ids...)
+}
+
+// ClearPriceChanges clears all "price_changes" edges to the PriceChange entity.
+func (iuo *ItemUpdateOne) ClearPriceChanges() *ItemUpdateOne {
+	iuo.mutation.ClearPriceChanges()
+	return iuo
+}
+
+// RemovePriceChangeIDs removes the "price_changes" edge to PriceChange entities by IDs.
+func (iuo *ItemUpdateOne) RemovePriceChangeIDs(ids ...int) *ItemUpdateOne {
+	iuo.mutation.RemovePriceChangeIDs(ids...)
+	return iuo
+}
+
+// RemovePriceChanges removes "price_changes" edges to PriceChange entities.
+func (iuo *ItemUpdateOne) RemovePriceChanges(p ...*PriceChange) *ItemUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return iuo.RemovePriceChangeIDs(ids...)
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (iuo *ItemUpdateOne) ClearCategories() *ItemUpdateOne {
+	iuo.mutation.ClearCategories()
+	return iuo
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (iuo *ItemUpdateOne) RemoveCategoryIDs(ids ...int) *ItemUpdateOne {
+	iuo.mutation.RemoveCategoryIDs(ids...)
+	return iuo
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (iuo *ItemUpdateOne) RemoveCategories(c ...*Category) *ItemUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iuo.RemoveCategoryIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (iuo *ItemUpdateOne) ClearTags() *ItemUpdateOne {
+	iuo.mutation.ClearTags()
+	return iuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (iuo *ItemUpdateOne) RemoveTagIDs(ids ...int) *ItemUpdateOne {
+	iuo.mutation.RemoveTagIDs(ids...)
+	return iuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (iuo *ItemUpdateOne) RemoveTags(t ...*Tag) *ItemUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return iuo.RemoveTagIDs(ids...)
+}
+
 // Where appends a list predicates to the ItemUpdate builder.
 func (iuo *ItemUpdateOne) Where(ps ...predicate.Item) *ItemUpdateOne {
 	iuo.mutation.Where(ps...)
@@ -278,9 +1153,25 @@ func (iuo *ItemUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Item.name": %w`, err)}
 		}
 	}
+	if v, ok := iuo.mutation.Currency(); ok {
+		if err := item.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Item.currency": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Stock(); ok {
+		if err := item.StockValidator(v); err != nil {
+			return &ValidationError{Name: "stock", err: fmt.Errorf(`ent: validator failed for field "Item.stock": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iuo *ItemUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemUpdateOne {
+	iuo.modifiers = append(iuo.modifiers, modifiers...)
+	return iuo
+}
+
 func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
 	if err := iuo.check(); err != nil {
 		return _node, err
@@ -310,6 +1201,12 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 			}
 		}
 	}
+	if value, ok := iuo.mutation.DeletedAt(); ok {
+		_spec.SetField(item.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(item.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := iuo.mutation.Name(); ok {
 		_spec.SetField(item.FieldName, field.TypeString, value)
 	}
@@ -319,12 +1216,282 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	if value, ok := iuo.mutation.AddedPrice(); ok {
 		_spec.AddField(item.FieldPrice, field.TypeInt, value)
 	}
+	if value, ok := iuo.mutation.Currency(); ok {
+		_spec.SetField(item.FieldCurrency, field.TypeString, value)
+	}
 	if value, ok := iuo.mutation.Description(); ok {
 		_spec.SetField(item.FieldDescription, field.TypeString, value)
 	}
 	if iuo.mutation.DescriptionCleared() {
 		_spec.ClearField(item.FieldDescription, field.TypeString)
 	}
+	if value, ok := iuo.mutation.Stock(); ok {
+		_spec.SetField(item.FieldStock, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedStock(); ok {
+		_spec.AddField(item.FieldStock, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.Version(); ok {
+		_spec.SetField(item.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedVersion(); ok {
+		_spec.AddField(item.FieldVersion, field.TypeInt, value)
+	}
+	if iuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.OwnerTable,
+			Columns: []string{item.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.OwnerTable,
+			Columns: []string{item.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !iuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.RevisionsTable,
+			Columns: []string{item.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.ImagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ImagesTable,
+			Columns: []string{item.ImagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedImagesIDs(); len(nodes) > 0 && !iuo.mutation.ImagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ImagesTable,
+			Columns: []string{item.ImagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.ImagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ImagesTable,
+			Columns: []string{item.ImagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemimage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.PriceChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceChangesTable,
+			Columns: []string{item.PriceChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedPriceChangesIDs(); len(nodes) > 0 && !iuo.mutation.PriceChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceChangesTable,
+			Columns: []string{item.PriceChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.PriceChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceChangesTable,
+			Columns: []string{item.PriceChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !iuo.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !iuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.TagsTable,
+			Columns: item.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iuo.modifiers...)
 	_node = &Item{config: iuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
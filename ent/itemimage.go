@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gin-crud/ent/item"
+	"gin-crud/ent/itemimage"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ItemImage is the model entity for the ItemImage schema.
+type ItemImage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID int64 `json:"item_id,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"-"`
+	// ThumbnailKey holds the value of the "thumbnail_key" field.
+	ThumbnailKey string `json:"-"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// ThumbnailURL holds the value of the "thumbnail_url" field.
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Width holds the value of the "width" field.
+	Width int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height int `json:"height,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemImageQuery when eager-loading is set.
+	Edges        ItemImageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ItemImageEdges holds the relations/edges for other nodes in the graph.
+type ItemImageEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemImageEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemImage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemimage.FieldID, itemimage.FieldItemID, itemimage.FieldSize, itemimage.FieldWidth, itemimage.FieldHeight:
+			values[i] = new(sql.NullInt64)
+		case itemimage.FieldKey, itemimage.FieldThumbnailKey, itemimage.FieldURL, itemimage.FieldThumbnailURL, itemimage.FieldContentType:
+			values[i] = new(sql.NullString)
+		case itemimage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemImage fields.
+func (ii *ItemImage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemimage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ii.ID = int(value.Int64)
+		case itemimage.FieldItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				ii.ItemID = value.Int64
+			}
+		case itemimage.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ii.Key = value.String
+			}
+		case itemimage.FieldThumbnailKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_key", values[i])
+			} else if value.Valid {
+				ii.ThumbnailKey = value.String
+			}
+		case itemimage.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				ii.URL = value.String
+			}
+		case itemimage.FieldThumbnailURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_url", values[i])
+			} else if value.Valid {
+				ii.ThumbnailURL = value.String
+			}
+		case itemimage.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				ii.ContentType = value.String
+			}
+		case itemimage.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				ii.Size = value.Int64
+			}
+		case itemimage.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				ii.Width = int(value.Int64)
+			}
+		case itemimage.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				ii.Height = int(value.Int64)
+			}
+		case itemimage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ii.CreatedAt = value.Time
+			}
+		default:
+			ii.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemImage.
+// This includes values selected through modifiers, order, etc.
+func (ii *ItemImage) Value(name string) (ent.Value, error) {
+	return ii.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the ItemImage entity.
+func (ii *ItemImage) QueryItem() *ItemQuery {
+	return NewItemImageClient(ii.config).QueryItem(ii)
+}
+
+// Update returns a builder for updating this ItemImage.
+// Note that you need to call ItemImage.Unwrap() before calling this method if this ItemImage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ii *ItemImage) Update() *ItemImageUpdateOne {
+	return NewItemImageClient(ii.config).UpdateOne(ii)
+}
+
+// Unwrap unwraps the ItemImage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ii *ItemImage) Unwrap() *ItemImage {
+	_tx, ok := ii.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemImage is not a transactional entity")
+	}
+	ii.config.driver = _tx.drv
+	return ii
+}
+
+// String implements the fmt.Stringer.
+func (ii *ItemImage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemImage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ii.ID))
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", ii.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(ii.URL)
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_url=")
+	builder.WriteString(ii.ThumbnailURL)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(ii.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Size))
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Height))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ii.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemImages is a parsable slice of ItemImage.
+type ItemImages []*ItemImage
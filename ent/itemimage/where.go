@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package itemimage
+
+import (
+	"gin-crud/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLTE(FieldID, id))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldItemID, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldKey, v))
+}
+
+// ThumbnailKey applies equality check predicate on the "thumbnail_key" field. It's identical to ThumbnailKeyEQ.
+func ThumbnailKey(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldThumbnailKey, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldURL, v))
+}
+
+// ThumbnailURL applies equality check predicate on the "thumbnail_url" field. It's identical to ThumbnailURLEQ.
+func ThumbnailURL(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldContentType, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldSize, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldHeight, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldContainsFold(FieldKey, v))
+}
+
+// ThumbnailKeyEQ applies the EQ predicate on the "thumbnail_key" field.
+func ThumbnailKeyEQ(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyNEQ applies the NEQ predicate on the "thumbnail_key" field.
+func ThumbnailKeyNEQ(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNEQ(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyIn applies the In predicate on the "thumbnail_key" field.
+func ThumbnailKeyIn(vs ...string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldIn(FieldThumbnailKey, vs...))
+}
+
+// ThumbnailKeyNotIn applies the NotIn predicate on the "thumbnail_key" field.
+func ThumbnailKeyNotIn(vs ...string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNotIn(FieldThumbnailKey, vs...))
+}
+
+// ThumbnailKeyGT applies the GT predicate on the "thumbnail_key" field.
+func ThumbnailKeyGT(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGT(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyGTE applies the GTE predicate on the "thumbnail_key" field.
+func ThumbnailKeyGTE(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGTE(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyLT applies the LT predicate on the "thumbnail_key" field.
+func ThumbnailKeyLT(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLT(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyLTE applies the LTE predicate on the "thumbnail_key" field.
+func ThumbnailKeyLTE(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLTE(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyContains applies the Contains predicate on the "thumbnail_key" field.
+func ThumbnailKeyContains(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldContains(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyHasPrefix applies the HasPrefix predicate on the "thumbnail_key" field.
+func ThumbnailKeyHasPrefix(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldHasPrefix(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyHasSuffix applies the HasSuffix predicate on the "thumbnail_key" field.
+func ThumbnailKeyHasSuffix(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldHasSuffix(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyEqualFold applies the EqualFold predicate on the "thumbnail_key" field.
+func ThumbnailKeyEqualFold(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEqualFold(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyContainsFold applies the ContainsFold predicate on the "thumbnail_key" field.
+func ThumbnailKeyContainsFold(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldContainsFold(FieldThumbnailKey, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldContainsFold(FieldURL, v))
+}
+
+// ThumbnailURLEQ applies the EQ predicate on the "thumbnail_url" field.
+func ThumbnailURLEQ(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLNEQ applies the NEQ predicate on the "thumbnail_url" field.
+func ThumbnailURLNEQ(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIn applies the In predicate on the "thumbnail_url" field.
+func ThumbnailURLIn(vs ...string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLNotIn applies the NotIn predicate on the "thumbnail_url" field.
+func ThumbnailURLNotIn(vs ...string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNotIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLGT applies the GT predicate on the "thumbnail_url" field.
+func ThumbnailURLGT(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLGTE applies the GTE predicate on the "thumbnail_url" field.
+func ThumbnailURLGTE(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLT applies the LT predicate on the "thumbnail_url" field.
+func ThumbnailURLLT(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLTE applies the LTE predicate on the "thumbnail_url" field.
+func ThumbnailURLLTE(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContains applies the Contains predicate on the "thumbnail_url" field.
+func ThumbnailURLContains(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldContains(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasPrefix applies the HasPrefix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasPrefix(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldHasPrefix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasSuffix applies the HasSuffix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasSuffix(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldHasSuffix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLEqualFold applies the EqualFold predicate on the "thumbnail_url" field.
+func ThumbnailURLEqualFold(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEqualFold(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContainsFold applies the ContainsFold predicate on the "thumbnail_url" field.
+func ThumbnailURLContainsFold(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldContainsFold(FieldThumbnailURL, v))
+}
+
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldContentType, v))
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNEQ(FieldContentType, v))
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldIn(FieldContentType, vs...))
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNotIn(FieldContentType, vs...))
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGT(FieldContentType, v))
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGTE(FieldContentType, v))
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLT(FieldContentType, v))
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLTE(FieldContentType, v))
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldContains(FieldContentType, v))
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldHasPrefix(FieldContentType, v))
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldHasSuffix(FieldContentType, v))
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEqualFold(FieldContentType, v))
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldContainsFold(FieldContentType, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLTE(FieldSize, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLTE(FieldWidth, v))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLTE(FieldHeight, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ItemImage {
+	return predicate.ItemImage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.ItemImage {
+	return predicate.ItemImage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.ItemImage {
+	return predicate.ItemImage(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ItemImage) predicate.ItemImage {
+	return predicate.ItemImage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ItemImage) predicate.ItemImage {
+	return predicate.ItemImage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ItemImage) predicate.ItemImage {
+	return predicate.ItemImage(sql.NotPredicates(p))
+}
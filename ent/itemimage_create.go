@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud/ent/item"
+	"gin-crud/ent/itemimage"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ItemImageCreate is the builder for creating a ItemImage entity.
+type ItemImageCreate struct {
+	config
+	mutation *ItemImageMutation
+	hooks    []Hook
+}
+
+// SetItemID sets the "item_id" field.
+func (iic *ItemImageCreate) SetItemID(i int64) *ItemImageCreate {
+	iic.mutation.SetItemID(i)
+	return iic
+}
+
+// SetKey sets the "key" field.
+func (iic *ItemImageCreate) SetKey(s string) *ItemImageCreate {
+	iic.mutation.SetKey(s)
+	return iic
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (iic *ItemImageCreate) SetThumbnailKey(s string) *ItemImageCreate {
+	iic.mutation.SetThumbnailKey(s)
+	return iic
+}
+
+// SetURL sets the "url" field.
+func (iic *ItemImageCreate) SetURL(s string) *ItemImageCreate {
+	iic.mutation.SetURL(s)
+	return iic
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (iic *ItemImageCreate) SetThumbnailURL(s string) *ItemImageCreate {
+	iic.mutation.SetThumbnailURL(s)
+	return iic
+}
+
+// SetContentType sets the "content_type" field.
+func (iic *ItemImageCreate) SetContentType(s string) *ItemImageCreate {
+	iic.mutation.SetContentType(s)
+	return iic
+}
+
+// SetSize sets the "size" field.
+func (iic *ItemImageCreate) SetSize(i int64) *ItemImageCreate {
+	iic.mutation.SetSize(i)
+	return iic
+}
+
+// SetWidth sets the "width" field.
+func (iic *ItemImageCreate) SetWidth(i int) *ItemImageCreate {
+	iic.mutation.SetWidth(i)
+	return iic
+}
+
+// SetHeight sets the "height" field.
+func (iic *ItemImageCreate) SetHeight(i int) *ItemImageCreate {
+	iic.mutation.SetHeight(i)
+	return iic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iic *ItemImageCreate) SetCreatedAt(t time.Time) *ItemImageCreate {
+	iic.mutation.SetCreatedAt(t)
+	return iic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iic *ItemImageCreate) SetNillableCreatedAt(t *time.Time) *ItemImageCreate {
+	if t != nil {
+		iic.SetCreatedAt(*t)
+	}
+	return iic
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (iic *ItemImageCreate) SetItem(i *Item) *ItemImageCreate {
+	return iic.SetItemID(i.ID)
+}
+
+// Mutation returns the ItemImageMutation object of the builder.
+func (iic *ItemImageCreate) Mutation() *ItemImageMutation {
+	return iic.mutation
+}
+
+// Save creates the ItemImage in the database.
+func (iic *ItemImageCreate) Save(ctx context.Context) (*ItemImage, error) {
+	iic.defaults()
+	return withHooks(ctx, iic.sqlSave, iic.mutation, iic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iic *ItemImageCreate) SaveX(ctx context.Context) *ItemImage {
+	v, err := iic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iic *ItemImageCreate) Exec(ctx context.Context) error {
+	_, err := iic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iic *ItemImageCreate) ExecX(ctx context.Context) {
+	if err := iic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iic *ItemImageCreate) defaults() {
+	if _, ok := iic.mutation.CreatedAt(); !ok {
+		v := itemimage.DefaultCreatedAt()
+		iic.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iic *ItemImageCreate) check() error {
+	if _, ok := iic.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ItemImage.item_id"`)}
+	}
+	if _, ok := iic.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "ItemImage.key"`)}
+	}
+	if v, ok := iic.mutation.Key(); ok {
+		if err := itemimage.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ItemImage.key": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.ThumbnailKey(); !ok {
+		return &ValidationError{Name: "thumbnail_key", err: errors.New(`ent: missing required field "ItemImage.thumbnail_key"`)}
+	}
+	if v, ok := iic.mutation.ThumbnailKey(); ok {
+		if err := itemimage.ThumbnailKeyValidator(v); err != nil {
+			return &ValidationError{Name: "thumbnail_key", err: fmt.Errorf(`ent: validator failed for field "ItemImage.thumbnail_key": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "ItemImage.url"`)}
+	}
+	if _, ok := iic.mutation.ThumbnailURL(); !ok {
+		return &ValidationError{Name: "thumbnail_url", err: errors.New(`ent: missing required field "ItemImage.thumbnail_url"`)}
+	}
+	if _, ok := iic.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "ItemImage.content_type"`)}
+	}
+	if _, ok := iic.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "ItemImage.size"`)}
+	}
+	if _, ok := iic.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "ItemImage.width"`)}
+	}
+	if _, ok := iic.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "ItemImage.height"`)}
+	}
+	if _, ok := iic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ItemImage.created_at"`)}
+	}
+	if len(iic.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "ItemImage.item"`)}
+	}
+	return nil
+}
+
+func (iic *ItemImageCreate) sqlSave(ctx context.Context) (*ItemImage, error) {
+	if err := iic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	iic.mutation.id = &_node.ID
+	iic.mutation.done = true
+	return _node, nil
+}
+
+func (iic *ItemImageCreate) createSpec() (*ItemImage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemImage{config: iic.config}
+		_spec = sqlgraph.NewCreateSpec(itemimage.Table, sqlgraph.NewFieldSpec(itemimage.FieldID, field.TypeInt))
+	)
+	if value, ok := iic.mutation.Key(); ok {
+		_spec.SetField(itemimage.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := iic.mutation.ThumbnailKey(); ok {
+		_spec.SetField(itemimage.FieldThumbnailKey, field.TypeString, value)
+		_node.ThumbnailKey = value
+	}
+	if value, ok := iic.mutation.URL(); ok {
+		_spec.SetField(itemimage.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := iic.mutation.ThumbnailURL(); ok {
+		_spec.SetField(itemimage.FieldThumbnailURL, field.TypeString, value)
+		_node.ThumbnailURL = value
+	}
+	if value, ok := iic.mutation.ContentType(); ok {
+		_spec.SetField(itemimage.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := iic.mutation.Size(); ok {
+		_spec.SetField(itemimage.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := iic.mutation.Width(); ok {
+		_spec.SetField(itemimage.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := iic.mutation.Height(); ok {
+		_spec.SetField(itemimage.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	if value, ok := iic.mutation.CreatedAt(); ok {
+		_spec.SetField(itemimage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := iic.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemimage.ItemTable,
+			Columns: []string{itemimage.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemImageCreateBulk is the builder for creating many ItemImage entities in bulk.
+type ItemImageCreateBulk struct {
+	config
+	err      error
+	builders []*ItemImageCreate
+}
+
+// Save creates the ItemImage entities in the database.
+func (iicb *ItemImageCreateBulk) Save(ctx context.Context) ([]*ItemImage, error) {
+	if iicb.err != nil {
+		return nil, iicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iicb.builders))
+	nodes := make([]*ItemImage, len(iicb.builders))
+	mutators := make([]Mutator, len(iicb.builders))
+	for i := range iicb.builders {
+		func(i int, root context.Context) {
+			builder := iicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemImageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iicb *ItemImageCreateBulk) SaveX(ctx context.Context) []*ItemImage {
+	v, err := iicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iicb *ItemImageCreateBulk) Exec(ctx context.Context) error {
+	_, err := iicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iicb *ItemImageCreateBulk) ExecX(ctx context.Context) {
+	if err := iicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
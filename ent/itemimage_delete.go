@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gin-crud/ent/itemimage"
+	"gin-crud/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ItemImageDelete is the builder for deleting a ItemImage entity.
+type ItemImageDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemImageMutation
+}
+
+// Where appends a list predicates to the ItemImageDelete builder.
+func (iid *ItemImageDelete) Where(ps ...predicate.ItemImage) *ItemImageDelete {
+	iid.mutation.Where(ps...)
+	return iid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (iid *ItemImageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, iid.sqlExec, iid.mutation, iid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iid *ItemImageDelete) ExecX(ctx context.Context) int {
+	n, err := iid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (iid *ItemImageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(itemimage.Table, sqlgraph.NewFieldSpec(itemimage.FieldID, field.TypeInt))
+	if ps := iid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, iid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	iid.mutation.done = true
+	return affected, err
+}
+
+// ItemImageDeleteOne is the builder for deleting a single ItemImage entity.
+type ItemImageDeleteOne struct {
+	iid *ItemImageDelete
+}
+
+// Where appends a list predicates to the ItemImageDelete builder.
+func (iido *ItemImageDeleteOne) Where(ps ...predicate.ItemImage) *ItemImageDeleteOne {
+	iido.iid.mutation.Where(ps...)
+	return iido
+}
+
+// Exec executes the deletion query.
+func (iido *ItemImageDeleteOne) Exec(ctx context.Context) error {
+	n, err := iido.iid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{itemimage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iido *ItemImageDeleteOne) ExecX(ctx context.Context) {
+	if err := iido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gin-crud/ent/item"
+	"gin-crud/ent/itemimage"
+	"gin-crud/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ItemImageQuery is the builder for querying ItemImage entities.
+type ItemImageQuery struct {
+	config
+	ctx        *QueryContext
+	order      []itemimage.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ItemImage
+	withItem   *ItemQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemImageQuery builder.
+func (iiq *ItemImageQuery) Where(ps ...predicate.ItemImage) *ItemImageQuery {
+	iiq.predicates = append(iiq.predicates, ps...)
+	return iiq
+}
+
+// Limit the number of records to be returned by this query.
+func (iiq *ItemImageQuery) Limit(limit int) *ItemImageQuery {
+	iiq.ctx.Limit = &limit
+	return iiq
+}
+
+// Offset to start from.
+func (iiq *ItemImageQuery) Offset(offset int) *ItemImageQuery {
+	iiq.ctx.Offset = &offset
+	return iiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iiq *ItemImageQuery) Unique(unique bool) *ItemImageQuery {
+	iiq.ctx.Unique = &unique
+	return iiq
+}
+
+// Order specifies how the records should be ordered.
+func (iiq *ItemImageQuery) Order(o ...itemimage.OrderOption) *ItemImageQuery {
+	iiq.order = append(iiq.order, o...)
+	return iiq
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (iiq *ItemImageQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: iiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemimage.Table, itemimage.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemimage.ItemTable, itemimage.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ItemImage entity from the query.
+// Returns a *NotFoundError when no ItemImage was found.
+func (iiq *ItemImageQuery) First(ctx context.Context) (*ItemImage, error) {
+	nodes, err := iiq.Limit(1).All(setContextOp(ctx, iiq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{itemimage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iiq *ItemImageQuery) FirstX(ctx context.Context) *ItemImage {
+	node, err := iiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemImage ID from the query.
+// Returns a *NotFoundError when no ItemImage ID was found.
+func (iiq *ItemImageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iiq.Limit(1).IDs(setContextOp(ctx, iiq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{itemimage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iiq *ItemImageQuery) FirstIDX(ctx context.Context) int {
+	id, err := iiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemImage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ItemImage entity is found.
+// Returns a *NotFoundError when no ItemImage entities are found.
+func (iiq *ItemImageQuery) Only(ctx context.Context) (*ItemImage, error) {
+	nodes, err := iiq.Limit(2).All(setContextOp(ctx, iiq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{itemimage.Label}
+	default:
+		return nil, &NotSingularError{itemimage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iiq *ItemImageQuery) OnlyX(ctx context.Context) *ItemImage {
+	node, err := iiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemImage ID in the query.
+// Returns a *NotSingularError when more than one ItemImage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iiq *ItemImageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iiq.Limit(2).IDs(setContextOp(ctx, iiq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{itemimage.Label}
+	default:
+		err = &NotSingularError{itemimage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iiq *ItemImageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := iiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemImages.
+func (iiq *ItemImageQuery) All(ctx context.Context) ([]*ItemImage, error) {
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryAll)
+	if err := iiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ItemImage, *ItemImageQuery]()
+	return withInterceptors[[]*ItemImage](ctx, iiq, qr, iiq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iiq *ItemImageQuery) AllX(ctx context.Context) []*ItemImage {
+	nodes, err := iiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemImage IDs.
+func (iiq *ItemImageQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if iiq.ctx.Unique == nil && iiq.path != nil {
+		iiq.Unique(true)
+	}
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryIDs)
+	if err = iiq.Select(itemimage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iiq *ItemImageQuery) IDsX(ctx context.Context) []int {
+	ids, err := iiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iiq *ItemImageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryCount)
+	if err := iiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, iiq, querierCount[*ItemImageQuery](), iiq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iiq *ItemImageQuery) CountX(ctx context.Context) int {
+	count, err := iiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iiq *ItemImageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryExist)
+	switch _, err := iiq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iiq *ItemImageQuery) ExistX(ctx context.Context) bool {
+	exist, err := iiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemImageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iiq *ItemImageQuery) Clone() *ItemImageQuery {
+	if iiq == nil {
+		return nil
+	}
+	return &ItemImageQuery{
+		config:     iiq.config,
+		ctx:        iiq.ctx.Clone(),
+		order:      append([]itemimage.OrderOption{}, iiq.order...),
+		inters:     append([]Interceptor{}, iiq.inters...),
+		predicates: append([]predicate.ItemImage{}, iiq.predicates...),
+		withItem:   iiq.withItem.Clone(),
+		// clone intermediate query.
+		sql:       iiq.sql.Clone(),
+		path:      iiq.path,
+		modifiers: append([]func(*sql.Selector){}, iiq.modifiers...),
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (iiq *ItemImageQuery) WithItem(opts ...func(*ItemQuery)) *ItemImageQuery {
+	query := (&ItemClient{config: iiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iiq.withItem = query
+	return iiq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ItemID int64 `json:"item_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemImage.Query().
+//		GroupBy(itemimage.FieldItemID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (iiq *ItemImageQuery) GroupBy(field string, fields ...string) *ItemImageGroupBy {
+	iiq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ItemImageGroupBy{build: iiq}
+	grbuild.flds = &iiq.ctx.Fields
+	grbuild.label = itemimage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ItemID int64 `json:"item_id,omitempty"`
+//	}
+//
+//	client.ItemImage.Query().
+//		Select(itemimage.FieldItemID).
+//		Scan(ctx, &v)
+func (iiq *ItemImageQuery) Select(fields ...string) *ItemImageSelect {
+	iiq.ctx.Fields = append(iiq.ctx.Fields, fields...)
+	sbuild := &ItemImageSelect{ItemImageQuery: iiq}
+	sbuild.label = itemimage.Label
+	sbuild.flds, sbuild.scan = &iiq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ItemImageSelect configured with the given aggregations.
+func (iiq *ItemImageQuery) Aggregate(fns ...AggregateFunc) *ItemImageSelect {
+	return iiq.Select().Aggregate(fns...)
+}
+
+func (iiq *ItemImageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range iiq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iiq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range iiq.ctx.Fields {
+		if !itemimage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iiq.path != nil {
+		prev, err := iiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iiq.sql = prev
+	}
+	return nil
+}
+
+func (iiq *ItemImageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ItemImage, error) {
+	var (
+		nodes       = []*ItemImage{}
+		_spec       = iiq.querySpec()
+		loadedTypes = [1]bool{
+			iiq.withItem != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ItemImage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ItemImage{config: iiq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(iiq.modifiers) > 0 {
+		_spec.Modifiers = iiq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := iiq.withItem; query != nil {
+		if err := iiq.loadItem(ctx, query, nodes, nil,
+			func(n *ItemImage, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (iiq *ItemImageQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*ItemImage, init func(*ItemImage), assign func(*ItemImage, *Item)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*ItemImage)
+	for i := range nodes {
+		fk := nodes[i].ItemID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (iiq *ItemImageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iiq.querySpec()
+	if len(iiq.modifiers) > 0 {
+		_spec.Modifiers = iiq.modifiers
+	}
+	_spec.Node.Columns = iiq.ctx.Fields
+	if len(iiq.ctx.Fields) > 0 {
+		_spec.Unique = iiq.ctx.Unique != nil && *iiq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, iiq.driver, _spec)
+}
+
+func (iiq *ItemImageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(itemimage.Table, itemimage.Columns, sqlgraph.NewFieldSpec(itemimage.FieldID, field.TypeInt))
+	_spec.From = iiq.sql
+	if unique := iiq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if iiq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := iiq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemimage.FieldID)
+		for i := range fields {
+			if fields[i] != itemimage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if iiq.withItem != nil {
+			_spec.Node.AddColumnOnce(itemimage.FieldItemID)
+		}
+	}
+	if ps := iiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iiq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iiq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iiq *ItemImageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iiq.driver.Dialect())
+	t1 := builder.Table(itemimage.Table)
+	columns := iiq.ctx.Fields
+	if len(columns) == 0 {
+		columns = itemimage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iiq.sql != nil {
+		selector = iiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iiq.ctx.Unique != nil && *iiq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range iiq.modifiers {
+		m(selector)
+	}
+	for _, p := range iiq.predicates {
+		p(selector)
+	}
+	for _, p := range iiq.order {
+		p(selector)
+	}
+	if offset := iiq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iiq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iiq *ItemImageQuery) Modify(modifiers ...func(s *sql.Selector)) *ItemImageSelect {
+	iiq.modifiers = append(iiq.modifiers, modifiers...)
+	return iiq.Select()
+}
+
+// ItemImageGroupBy is the group-by builder for ItemImage entities.
+type ItemImageGroupBy struct {
+	selector
+	build *ItemImageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iigb *ItemImageGroupBy) Aggregate(fns ...AggregateFunc) *ItemImageGroupBy {
+	iigb.fns = append(iigb.fns, fns...)
+	return iigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iigb *ItemImageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iigb.build.ctx, ent.OpQueryGroupBy)
+	if err := iigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemImageQuery, *ItemImageGroupBy](ctx, iigb.build, iigb, iigb.build.inters, v)
+}
+
+func (iigb *ItemImageGroupBy) sqlScan(ctx context.Context, root *ItemImageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(iigb.fns))
+	for _, fn := range iigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*iigb.flds)+len(iigb.fns))
+		for _, f := range *iigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*iigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ItemImageSelect is the builder for selecting fields of ItemImage entities.
+type ItemImageSelect struct {
+	*ItemImageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (iis *ItemImageSelect) Aggregate(fns ...AggregateFunc) *ItemImageSelect {
+	iis.fns = append(iis.fns, fns...)
+	return iis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iis *ItemImageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iis.ctx, ent.OpQuerySelect)
+	if err := iis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemImageQuery, *ItemImageSelect](ctx, iis.ItemImageQuery, iis, iis.inters, v)
+}
+
+func (iis *ItemImageSelect) sqlScan(ctx context.Context, root *ItemImageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(iis.fns))
+	for _, fn := range iis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*iis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iis *ItemImageSelect) Modify(modifiers ...func(s *sql.Selector)) *ItemImageSelect {
+	iis.modifiers = append(iis.modifiers, modifiers...)
+	return iis
+}
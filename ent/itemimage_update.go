@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud/ent/itemimage"
+	"gin-crud/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ItemImageUpdate is the builder for updating ItemImage entities.
+type ItemImageUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ItemImageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ItemImageUpdate builder.
+func (iiu *ItemImageUpdate) Where(ps ...predicate.ItemImage) *ItemImageUpdate {
+	iiu.mutation.Where(ps...)
+	return iiu
+}
+
+// Mutation returns the ItemImageMutation object of the builder.
+func (iiu *ItemImageUpdate) Mutation() *ItemImageMutation {
+	return iiu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iiu *ItemImageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iiu.sqlSave, iiu.mutation, iiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiu *ItemImageUpdate) SaveX(ctx context.Context) int {
+	affected, err := iiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iiu *ItemImageUpdate) Exec(ctx context.Context) error {
+	_, err := iiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiu *ItemImageUpdate) ExecX(ctx context.Context) {
+	if err := iiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iiu *ItemImageUpdate) check() error {
+	if iiu.mutation.ItemCleared() && len(iiu.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemImage.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iiu *ItemImageUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemImageUpdate {
+	iiu.modifiers = append(iiu.modifiers, modifiers...)
+	return iiu
+}
+
+func (iiu *ItemImageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemimage.Table, itemimage.Columns, sqlgraph.NewFieldSpec(itemimage.FieldID, field.TypeInt))
+	if ps := iiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(iiu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, iiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemimage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iiu.mutation.done = true
+	return n, nil
+}
+
+// ItemImageUpdateOne is the builder for updating a single ItemImage entity.
+type ItemImageUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ItemImageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the ItemImageMutation object of the builder.
+func (iiuo *ItemImageUpdateOne) Mutation() *ItemImageMutation {
+	return iiuo.mutation
+}
+
+// Where appends a list predicates to the ItemImageUpdate builder.
+func (iiuo *ItemImageUpdateOne) Where(ps ...predicate.ItemImage) *ItemImageUpdateOne {
+	iiuo.mutation.Where(ps...)
+	return iiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iiuo *ItemImageUpdateOne) Select(field string, fields ...string) *ItemImageUpdateOne {
+	iiuo.fields = append([]string{field}, fields...)
+	return iiuo
+}
+
+// Save executes the query and returns the updated ItemImage entity.
+func (iiuo *ItemImageUpdateOne) Save(ctx context.Context) (*ItemImage, error) {
+	return withHooks(ctx, iiuo.sqlSave, iiuo.mutation, iiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiuo *ItemImageUpdateOne) SaveX(ctx context.Context) *ItemImage {
+	node, err := iiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iiuo *ItemImageUpdateOne) Exec(ctx context.Context) error {
+	_, err := iiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiuo *ItemImageUpdateOne) ExecX(ctx context.Context) {
+	if err := iiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iiuo *ItemImageUpdateOne) check() error {
+	if iiuo.mutation.ItemCleared() && len(iiuo.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemImage.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iiuo *ItemImageUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemImageUpdateOne {
+	iiuo.modifiers = append(iiuo.modifiers, modifiers...)
+	return iiuo
+}
+
+func (iiuo *ItemImageUpdateOne) sqlSave(ctx context.Context) (_node *ItemImage, err error) {
+	if err := iiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemimage.Table, itemimage.Columns, sqlgraph.NewFieldSpec(itemimage.FieldID, field.TypeInt))
+	id, ok := iiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemImage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemimage.FieldID)
+		for _, f := range fields {
+			if !itemimage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemimage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(iiuo.modifiers...)
+	_node = &ItemImage{config: iiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemimage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iiuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gin-crud/ent/item"
+	"gin-crud/ent/itemrevision"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ItemRevision is the model entity for the ItemRevision schema.
+type ItemRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID int64 `json:"item_id,omitempty"`
+	// Revision holds the value of the "revision" field.
+	Revision int `json:"revision,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Price holds the value of the "price" field.
+	Price int `json:"price,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Stock holds the value of the "stock" field.
+	Stock int `json:"stock,omitempty"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID *int `json:"actor_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemRevisionQuery when eager-loading is set.
+	Edges        ItemRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ItemRevisionEdges holds the relations/edges for other nodes in the graph.
+type ItemRevisionEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemRevisionEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemrevision.FieldID, itemrevision.FieldItemID, itemrevision.FieldRevision, itemrevision.FieldPrice, itemrevision.FieldStock, itemrevision.FieldActorID:
+			values[i] = new(sql.NullInt64)
+		case itemrevision.FieldName, itemrevision.FieldCurrency, itemrevision.FieldDescription:
+			values[i] = new(sql.NullString)
+		case itemrevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemRevision fields.
+func (ir *ItemRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemrevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ir.ID = int(value.Int64)
+		case itemrevision.FieldItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				ir.ItemID = value.Int64
+			}
+		case itemrevision.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				ir.Revision = int(value.Int64)
+			}
+		case itemrevision.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ir.Name = value.String
+			}
+		case itemrevision.FieldPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				ir.Price = int(value.Int64)
+			}
+		case itemrevision.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				ir.Currency = value.String
+			}
+		case itemrevision.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ir.Description = value.String
+			}
+		case itemrevision.FieldStock:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stock", values[i])
+			} else if value.Valid {
+				ir.Stock = int(value.Int64)
+			}
+		case itemrevision.FieldActorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				ir.ActorID = new(int)
+				*ir.ActorID = int(value.Int64)
+			}
+		case itemrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ir.CreatedAt = value.Time
+			}
+		default:
+			ir.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemRevision.
+// This includes values selected through modifiers, order, etc.
+func (ir *ItemRevision) Value(name string) (ent.Value, error) {
+	return ir.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the ItemRevision entity.
+func (ir *ItemRevision) QueryItem() *ItemQuery {
+	return NewItemRevisionClient(ir.config).QueryItem(ir)
+}
+
+// Update returns a builder for updating this ItemRevision.
+// Note that you need to call ItemRevision.Unwrap() before calling this method if this ItemRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ir *ItemRevision) Update() *ItemRevisionUpdateOne {
+	return NewItemRevisionClient(ir.config).UpdateOne(ir)
+}
+
+// Unwrap unwraps the ItemRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ir *ItemRevision) Unwrap() *ItemRevision {
+	_tx, ok := ir.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemRevision is not a transactional entity")
+	}
+	ir.config.driver = _tx.drv
+	return ir
+}
+
+// String implements the fmt.Stringer.
+func (ir *ItemRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ir.ID))
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", ir.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ir.Name)
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Price))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(ir.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ir.Description)
+	builder.WriteString(", ")
+	builder.WriteString("stock=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Stock))
+	builder.WriteString(", ")
+	if v := ir.ActorID; v != nil {
+		builder.WriteString("actor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ir.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemRevisions is a parsable slice of ItemRevision.
+type ItemRevisions []*ItemRevision
@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud/ent/item"
+	"gin-crud/ent/itemrevision"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ItemRevisionCreate is the builder for creating a ItemRevision entity.
+type ItemRevisionCreate struct {
+	config
+	mutation *ItemRevisionMutation
+	hooks    []Hook
+}
+
+// SetItemID sets the "item_id" field.
+func (irc *ItemRevisionCreate) SetItemID(i int64) *ItemRevisionCreate {
+	irc.mutation.SetItemID(i)
+	return irc
+}
+
+// SetRevision sets the "revision" field.
+func (irc *ItemRevisionCreate) SetRevision(i int) *ItemRevisionCreate {
+	irc.mutation.SetRevision(i)
+	return irc
+}
+
+// SetName sets the "name" field.
+func (irc *ItemRevisionCreate) SetName(s string) *ItemRevisionCreate {
+	irc.mutation.SetName(s)
+	return irc
+}
+
+// SetPrice sets the "price" field.
+func (irc *ItemRevisionCreate) SetPrice(i int) *ItemRevisionCreate {
+	irc.mutation.SetPrice(i)
+	return irc
+}
+
+// SetCurrency sets the "currency" field.
+func (irc *ItemRevisionCreate) SetCurrency(s string) *ItemRevisionCreate {
+	irc.mutation.SetCurrency(s)
+	return irc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (irc *ItemRevisionCreate) SetNillableCurrency(s *string) *ItemRevisionCreate {
+	if s != nil {
+		irc.SetCurrency(*s)
+	}
+	return irc
+}
+
+// SetDescription sets the "description" field.
+func (irc *ItemRevisionCreate) SetDescription(s string) *ItemRevisionCreate {
+	irc.mutation.SetDescription(s)
+	return irc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (irc *ItemRevisionCreate) SetNillableDescription(s *string) *ItemRevisionCreate {
+	if s != nil {
+		irc.SetDescription(*s)
+	}
+	return irc
+}
+
+// SetStock sets the "stock" field.
+func (irc *ItemRevisionCreate) SetStock(i int) *ItemRevisionCreate {
+	irc.mutation.SetStock(i)
+	return irc
+}
+
+// SetActorID sets the "actor_id" field.
+func (irc *ItemRevisionCreate) SetActorID(i int) *ItemRevisionCreate {
+	irc.mutation.SetActorID(i)
+	return irc
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (irc *ItemRevisionCreate) SetNillableActorID(i *int) *ItemRevisionCreate {
+	if i != nil {
+		irc.SetActorID(*i)
+	}
+	return irc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (irc *ItemRevisionCreate) SetCreatedAt(t time.Time) *ItemRevisionCreate {
+	irc.mutation.SetCreatedAt(t)
+	return irc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (irc *ItemRevisionCreate) SetNillableCreatedAt(t *time.Time) *ItemRevisionCreate {
+	if t != nil {
+		irc.SetCreatedAt(*t)
+	}
+	return irc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (irc *ItemRevisionCreate) SetItem(i *Item) *ItemRevisionCreate {
+	return irc.SetItemID(i.ID)
+}
+
+// Mutation returns the ItemRevisionMutation object of the builder.
+func (irc *ItemRevisionCreate) Mutation() *ItemRevisionMutation {
+	return irc.mutation
+}
+
+// Save creates the ItemRevision in the database.
+func (irc *ItemRevisionCreate) Save(ctx context.Context) (*ItemRevision, error) {
+	irc.defaults()
+	return withHooks(ctx, irc.sqlSave, irc.mutation, irc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (irc *ItemRevisionCreate) SaveX(ctx context.Context) *ItemRevision {
+	v, err := irc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (irc *ItemRevisionCreate) Exec(ctx context.Context) error {
+	_, err := irc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irc *ItemRevisionCreate) ExecX(ctx context.Context) {
+	if err := irc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (irc *ItemRevisionCreate) defaults() {
+	if _, ok := irc.mutation.Currency(); !ok {
+		v := itemrevision.DefaultCurrency
+		irc.mutation.SetCurrency(v)
+	}
+	if _, ok := irc.mutation.CreatedAt(); !ok {
+		v := itemrevision.DefaultCreatedAt()
+		irc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (irc *ItemRevisionCreate) check() error {
+	if _, ok := irc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ItemRevision.item_id"`)}
+	}
+	if _, ok := irc.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "ItemRevision.revision"`)}
+	}
+	if _, ok := irc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ItemRevision.name"`)}
+	}
+	if _, ok := irc.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "ItemRevision.price"`)}
+	}
+	if _, ok := irc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "ItemRevision.currency"`)}
+	}
+	if _, ok := irc.mutation.Stock(); !ok {
+		return &ValidationError{Name: "stock", err: errors.New(`ent: missing required field "ItemRevision.stock"`)}
+	}
+	if _, ok := irc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ItemRevision.created_at"`)}
+	}
+	if len(irc.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "ItemRevision.item"`)}
+	}
+	return nil
+}
+
+func (irc *ItemRevisionCreate) sqlSave(ctx context.Context) (*ItemRevision, error) {
+	if err := irc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := irc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, irc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	irc.mutation.id = &_node.ID
+	irc.mutation.done = true
+	return _node, nil
+}
+
+func (irc *ItemRevisionCreate) createSpec() (*ItemRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemRevision{config: irc.config}
+		_spec = sqlgraph.NewCreateSpec(itemrevision.Table, sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeInt))
+	)
+	if value, ok := irc.mutation.Revision(); ok {
+		_spec.SetField(itemrevision.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := irc.mutation.Name(); ok {
+		_spec.SetField(itemrevision.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := irc.mutation.Price(); ok {
+		_spec.SetField(itemrevision.FieldPrice, field.TypeInt, value)
+		_node.Price = value
+	}
+	if value, ok := irc.mutation.Currency(); ok {
+		_spec.SetField(itemrevision.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := irc.mutation.Description(); ok {
+		_spec.SetField(itemrevision.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := irc.mutation.Stock(); ok {
+		_spec.SetField(itemrevision.FieldStock, field.TypeInt, value)
+		_node.Stock = value
+	}
+	if value, ok := irc.mutation.ActorID(); ok {
+		_spec.SetField(itemrevision.FieldActorID, field.TypeInt, value)
+		_node.ActorID = &value
+	}
+	if value, ok := irc.mutation.CreatedAt(); ok {
+		_spec.SetField(itemrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := irc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemrevision.ItemTable,
+			Columns: []string{itemrevision.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemRevisionCreateBulk is the builder for creating many ItemRevision entities in bulk.
+type ItemRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*ItemRevisionCreate
+}
+
+// Save creates the ItemRevision entities in the database.
+func (ircb *ItemRevisionCreateBulk) Save(ctx context.Context) ([]*ItemRevision, error) {
+	if ircb.err != nil {
+		return nil, ircb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ircb.builders))
+	nodes := make([]*ItemRevision, len(ircb.builders))
+	mutators := make([]Mutator, len(ircb.builders))
+	for i := range ircb.builders {
+		func(i int, root context.Context) {
+			builder := ircb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ircb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ircb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ircb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ircb *ItemRevisionCreateBulk) SaveX(ctx context.Context) []*ItemRevision {
+	v, err := ircb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ircb *ItemRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := ircb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ircb *ItemRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := ircb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
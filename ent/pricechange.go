@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gin-crud/ent/item"
+	"gin-crud/ent/pricechange"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PriceChange is the model entity for the PriceChange schema.
+type PriceChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID int64 `json:"item_id,omitempty"`
+	// Price holds the value of the "price" field.
+	Price int `json:"price,omitempty"`
+	// EffectiveFrom holds the value of the "effective_from" field.
+	EffectiveFrom time.Time `json:"effective_from,omitempty"`
+	// EffectiveTo holds the value of the "effective_to" field.
+	EffectiveTo *time.Time `json:"effective_to,omitempty"`
+	// Status holds the value of the "status" field.
+	Status pricechange.Status `json:"status,omitempty"`
+	// PreviousPrice holds the value of the "previous_price" field.
+	PreviousPrice *int `json:"previous_price,omitempty"`
+	// AppliedAt holds the value of the "applied_at" field.
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy *int `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PriceChangeQuery when eager-loading is set.
+	Edges        PriceChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PriceChangeEdges holds the relations/edges for other nodes in the graph.
+type PriceChangeEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PriceChangeEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PriceChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pricechange.FieldID, pricechange.FieldItemID, pricechange.FieldPrice, pricechange.FieldPreviousPrice, pricechange.FieldCreatedBy:
+			values[i] = new(sql.NullInt64)
+		case pricechange.FieldStatus:
+			values[i] = new(sql.NullString)
+		case pricechange.FieldEffectiveFrom, pricechange.FieldEffectiveTo, pricechange.FieldAppliedAt, pricechange.FieldEndedAt, pricechange.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PriceChange fields.
+func (pc *PriceChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pricechange.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pc.ID = int(value.Int64)
+		case pricechange.FieldItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				pc.ItemID = value.Int64
+			}
+		case pricechange.FieldPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				pc.Price = int(value.Int64)
+			}
+		case pricechange.FieldEffectiveFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_from", values[i])
+			} else if value.Valid {
+				pc.EffectiveFrom = value.Time
+			}
+		case pricechange.FieldEffectiveTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_to", values[i])
+			} else if value.Valid {
+				pc.EffectiveTo = new(time.Time)
+				*pc.EffectiveTo = value.Time
+			}
+		case pricechange.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pc.Status = pricechange.Status(value.String)
+			}
+		case pricechange.FieldPreviousPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_price", values[i])
+			} else if value.Valid {
+				pc.PreviousPrice = new(int)
+				*pc.PreviousPrice = int(value.Int64)
+			}
+		case pricechange.FieldAppliedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_at", values[i])
+			} else if value.Valid {
+				pc.AppliedAt = new(time.Time)
+				*pc.AppliedAt = value.Time
+			}
+		case pricechange.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				pc.EndedAt = new(time.Time)
+				*pc.EndedAt = value.Time
+			}
+		case pricechange.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				pc.CreatedBy = new(int)
+				*pc.CreatedBy = int(value.Int64)
+			}
+		case pricechange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pc.CreatedAt = value.Time
+			}
+		default:
+			pc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PriceChange.
+// This includes values selected through modifiers, order, etc.
+func (pc *PriceChange) Value(name string) (ent.Value, error) {
+	return pc.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the PriceChange entity.
+func (pc *PriceChange) QueryItem() *ItemQuery {
+	return NewPriceChangeClient(pc.config).QueryItem(pc)
+}
+
+// Update returns a builder for updating this PriceChange.
+// Note that you need to call PriceChange.Unwrap() before calling this method if this PriceChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pc *PriceChange) Update() *PriceChangeUpdateOne {
+	return NewPriceChangeClient(pc.config).UpdateOne(pc)
+}
+
+// Unwrap unwraps the PriceChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pc *PriceChange) Unwrap() *PriceChange {
+	_tx, ok := pc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PriceChange is not a transactional entity")
+	}
+	pc.config.driver = _tx.drv
+	return pc
+}
+
+// String implements the fmt.Stringer.
+func (pc *PriceChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("PriceChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pc.ID))
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", pc.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", pc.Price))
+	builder.WriteString(", ")
+	builder.WriteString("effective_from=")
+	builder.WriteString(pc.EffectiveFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := pc.EffectiveTo; v != nil {
+		builder.WriteString("effective_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", pc.Status))
+	builder.WriteString(", ")
+	if v := pc.PreviousPrice; v != nil {
+		builder.WriteString("previous_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pc.AppliedAt; v != nil {
+		builder.WriteString("applied_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pc.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pc.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PriceChanges is a parsable slice of PriceChange.
+type PriceChanges []*PriceChange
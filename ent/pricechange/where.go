@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pricechange
+
+import (
+	"gin-crud/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLTE(FieldID, id))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v int64) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldItemID, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldPrice, v))
+}
+
+// EffectiveFrom applies equality check predicate on the "effective_from" field. It's identical to EffectiveFromEQ.
+func EffectiveFrom(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveTo applies equality check predicate on the "effective_to" field. It's identical to EffectiveToEQ.
+func EffectiveTo(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldEffectiveTo, v))
+}
+
+// PreviousPrice applies equality check predicate on the "previous_price" field. It's identical to PreviousPriceEQ.
+func PreviousPrice(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldPreviousPrice, v))
+}
+
+// AppliedAt applies equality check predicate on the "applied_at" field. It's identical to AppliedAtEQ.
+func AppliedAt(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v int64) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v int64) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...int64) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...int64) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLTE(FieldPrice, v))
+}
+
+// EffectiveFromEQ applies the EQ predicate on the "effective_from" field.
+func EffectiveFromEQ(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromNEQ applies the NEQ predicate on the "effective_from" field.
+func EffectiveFromNEQ(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromIn applies the In predicate on the "effective_from" field.
+func EffectiveFromIn(vs ...time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromNotIn applies the NotIn predicate on the "effective_from" field.
+func EffectiveFromNotIn(vs ...time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromGT applies the GT predicate on the "effective_from" field.
+func EffectiveFromGT(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromGTE applies the GTE predicate on the "effective_from" field.
+func EffectiveFromGTE(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGTE(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLT applies the LT predicate on the "effective_from" field.
+func EffectiveFromLT(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLTE applies the LTE predicate on the "effective_from" field.
+func EffectiveFromLTE(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLTE(FieldEffectiveFrom, v))
+}
+
+// EffectiveToEQ applies the EQ predicate on the "effective_to" field.
+func EffectiveToEQ(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldEffectiveTo, v))
+}
+
+// EffectiveToNEQ applies the NEQ predicate on the "effective_to" field.
+func EffectiveToNEQ(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNEQ(FieldEffectiveTo, v))
+}
+
+// EffectiveToIn applies the In predicate on the "effective_to" field.
+func EffectiveToIn(vs ...time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIn(FieldEffectiveTo, vs...))
+}
+
+// EffectiveToNotIn applies the NotIn predicate on the "effective_to" field.
+func EffectiveToNotIn(vs ...time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotIn(FieldEffectiveTo, vs...))
+}
+
+// EffectiveToGT applies the GT predicate on the "effective_to" field.
+func EffectiveToGT(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGT(FieldEffectiveTo, v))
+}
+
+// EffectiveToGTE applies the GTE predicate on the "effective_to" field.
+func EffectiveToGTE(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGTE(FieldEffectiveTo, v))
+}
+
+// EffectiveToLT applies the LT predicate on the "effective_to" field.
+func EffectiveToLT(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLT(FieldEffectiveTo, v))
+}
+
+// EffectiveToLTE applies the LTE predicate on the "effective_to" field.
+func EffectiveToLTE(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLTE(FieldEffectiveTo, v))
+}
+
+// EffectiveToIsNil applies the IsNil predicate on the "effective_to" field.
+func EffectiveToIsNil() predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIsNull(FieldEffectiveTo))
+}
+
+// EffectiveToNotNil applies the NotNil predicate on the "effective_to" field.
+func EffectiveToNotNil() predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotNull(FieldEffectiveTo))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// PreviousPriceEQ applies the EQ predicate on the "previous_price" field.
+func PreviousPriceEQ(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldPreviousPrice, v))
+}
+
+// PreviousPriceNEQ applies the NEQ predicate on the "previous_price" field.
+func PreviousPriceNEQ(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNEQ(FieldPreviousPrice, v))
+}
+
+// PreviousPriceIn applies the In predicate on the "previous_price" field.
+func PreviousPriceIn(vs ...int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIn(FieldPreviousPrice, vs...))
+}
+
+// PreviousPriceNotIn applies the NotIn predicate on the "previous_price" field.
+func PreviousPriceNotIn(vs ...int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotIn(FieldPreviousPrice, vs...))
+}
+
+// PreviousPriceGT applies the GT predicate on the "previous_price" field.
+func PreviousPriceGT(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGT(FieldPreviousPrice, v))
+}
+
+// PreviousPriceGTE applies the GTE predicate on the "previous_price" field.
+func PreviousPriceGTE(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGTE(FieldPreviousPrice, v))
+}
+
+// PreviousPriceLT applies the LT predicate on the "previous_price" field.
+func PreviousPriceLT(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLT(FieldPreviousPrice, v))
+}
+
+// PreviousPriceLTE applies the LTE predicate on the "previous_price" field.
+func PreviousPriceLTE(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLTE(FieldPreviousPrice, v))
+}
+
+// PreviousPriceIsNil applies the IsNil predicate on the "previous_price" field.
+func PreviousPriceIsNil() predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIsNull(FieldPreviousPrice))
+}
+
+// PreviousPriceNotNil applies the NotNil predicate on the "previous_price" field.
+func PreviousPriceNotNil() predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotNull(FieldPreviousPrice))
+}
+
+// AppliedAtEQ applies the EQ predicate on the "applied_at" field.
+func AppliedAtEQ(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtNEQ applies the NEQ predicate on the "applied_at" field.
+func AppliedAtNEQ(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtIn applies the In predicate on the "applied_at" field.
+func AppliedAtIn(vs ...time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtNotIn applies the NotIn predicate on the "applied_at" field.
+func AppliedAtNotIn(vs ...time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtGT applies the GT predicate on the "applied_at" field.
+func AppliedAtGT(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGT(FieldAppliedAt, v))
+}
+
+// AppliedAtGTE applies the GTE predicate on the "applied_at" field.
+func AppliedAtGTE(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGTE(FieldAppliedAt, v))
+}
+
+// AppliedAtLT applies the LT predicate on the "applied_at" field.
+func AppliedAtLT(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLT(FieldAppliedAt, v))
+}
+
+// AppliedAtLTE applies the LTE predicate on the "applied_at" field.
+func AppliedAtLTE(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLTE(FieldAppliedAt, v))
+}
+
+// AppliedAtIsNil applies the IsNil predicate on the "applied_at" field.
+func AppliedAtIsNil() predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIsNull(FieldAppliedAt))
+}
+
+// AppliedAtNotNil applies the NotNil predicate on the "applied_at" field.
+func AppliedAtNotNil() predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotNull(FieldAppliedAt))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotNull(FieldEndedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v int) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PriceChange {
+	return predicate.PriceChange(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.PriceChange {
+	return predicate.PriceChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.PriceChange {
+	return predicate.PriceChange(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PriceChange) predicate.PriceChange {
+	return predicate.PriceChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PriceChange) predicate.PriceChange {
+	return predicate.PriceChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PriceChange) predicate.PriceChange {
+	return predicate.PriceChange(sql.NotPredicates(p))
+}
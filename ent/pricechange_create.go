@@ -0,0 +1,383 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud/ent/item"
+	"gin-crud/ent/pricechange"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceChangeCreate is the builder for creating a PriceChange entity.
+type PriceChangeCreate struct {
+	config
+	mutation *PriceChangeMutation
+	hooks    []Hook
+}
+
+// SetItemID sets the "item_id" field.
+func (pcc *PriceChangeCreate) SetItemID(i int64) *PriceChangeCreate {
+	pcc.mutation.SetItemID(i)
+	return pcc
+}
+
+// SetPrice sets the "price" field.
+func (pcc *PriceChangeCreate) SetPrice(i int) *PriceChangeCreate {
+	pcc.mutation.SetPrice(i)
+	return pcc
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (pcc *PriceChangeCreate) SetEffectiveFrom(t time.Time) *PriceChangeCreate {
+	pcc.mutation.SetEffectiveFrom(t)
+	return pcc
+}
+
+// SetEffectiveTo sets the "effective_to" field.
+func (pcc *PriceChangeCreate) SetEffectiveTo(t time.Time) *PriceChangeCreate {
+	pcc.mutation.SetEffectiveTo(t)
+	return pcc
+}
+
+// SetNillableEffectiveTo sets the "effective_to" field if the given value is not nil.
+func (pcc *PriceChangeCreate) SetNillableEffectiveTo(t *time.Time) *PriceChangeCreate {
+	if t != nil {
+		pcc.SetEffectiveTo(*t)
+	}
+	return pcc
+}
+
+// SetStatus sets the "status" field.
+func (pcc *PriceChangeCreate) SetStatus(pr pricechange.Status) *PriceChangeCreate {
+	pcc.mutation.SetStatus(pr)
+	return pcc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcc *PriceChangeCreate) SetNillableStatus(pr *pricechange.Status) *PriceChangeCreate {
+	if pr != nil {
+		pcc.SetStatus(*pr)
+	}
+	return pcc
+}
+
+// SetPreviousPrice sets the "previous_price" field.
+func (pcc *PriceChangeCreate) SetPreviousPrice(i int) *PriceChangeCreate {
+	pcc.mutation.SetPreviousPrice(i)
+	return pcc
+}
+
+// SetNillablePreviousPrice sets the "previous_price" field if the given value is not nil.
+func (pcc *PriceChangeCreate) SetNillablePreviousPrice(i *int) *PriceChangeCreate {
+	if i != nil {
+		pcc.SetPreviousPrice(*i)
+	}
+	return pcc
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (pcc *PriceChangeCreate) SetAppliedAt(t time.Time) *PriceChangeCreate {
+	pcc.mutation.SetAppliedAt(t)
+	return pcc
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (pcc *PriceChangeCreate) SetNillableAppliedAt(t *time.Time) *PriceChangeCreate {
+	if t != nil {
+		pcc.SetAppliedAt(*t)
+	}
+	return pcc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (pcc *PriceChangeCreate) SetEndedAt(t time.Time) *PriceChangeCreate {
+	pcc.mutation.SetEndedAt(t)
+	return pcc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (pcc *PriceChangeCreate) SetNillableEndedAt(t *time.Time) *PriceChangeCreate {
+	if t != nil {
+		pcc.SetEndedAt(*t)
+	}
+	return pcc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pcc *PriceChangeCreate) SetCreatedBy(i int) *PriceChangeCreate {
+	pcc.mutation.SetCreatedBy(i)
+	return pcc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pcc *PriceChangeCreate) SetNillableCreatedBy(i *int) *PriceChangeCreate {
+	if i != nil {
+		pcc.SetCreatedBy(*i)
+	}
+	return pcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pcc *PriceChangeCreate) SetCreatedAt(t time.Time) *PriceChangeCreate {
+	pcc.mutation.SetCreatedAt(t)
+	return pcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pcc *PriceChangeCreate) SetNillableCreatedAt(t *time.Time) *PriceChangeCreate {
+	if t != nil {
+		pcc.SetCreatedAt(*t)
+	}
+	return pcc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (pcc *PriceChangeCreate) SetItem(i *Item) *PriceChangeCreate {
+	return pcc.SetItemID(i.ID)
+}
+
+// Mutation returns the PriceChangeMutation object of the builder.
+func (pcc *PriceChangeCreate) Mutation() *PriceChangeMutation {
+	return pcc.mutation
+}
+
+// Save creates the PriceChange in the database.
+func (pcc *PriceChangeCreate) Save(ctx context.Context) (*PriceChange, error) {
+	pcc.defaults()
+	return withHooks(ctx, pcc.sqlSave, pcc.mutation, pcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcc *PriceChangeCreate) SaveX(ctx context.Context) *PriceChange {
+	v, err := pcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcc *PriceChangeCreate) Exec(ctx context.Context) error {
+	_, err := pcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcc *PriceChangeCreate) ExecX(ctx context.Context) {
+	if err := pcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcc *PriceChangeCreate) defaults() {
+	if _, ok := pcc.mutation.Status(); !ok {
+		v := pricechange.DefaultStatus
+		pcc.mutation.SetStatus(v)
+	}
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		v := pricechange.DefaultCreatedAt()
+		pcc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcc *PriceChangeCreate) check() error {
+	if _, ok := pcc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "PriceChange.item_id"`)}
+	}
+	if _, ok := pcc.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "PriceChange.price"`)}
+	}
+	if _, ok := pcc.mutation.EffectiveFrom(); !ok {
+		return &ValidationError{Name: "effective_from", err: errors.New(`ent: missing required field "PriceChange.effective_from"`)}
+	}
+	if _, ok := pcc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PriceChange.status"`)}
+	}
+	if v, ok := pcc.mutation.Status(); ok {
+		if err := pricechange.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PriceChange.status": %w`, err)}
+		}
+	}
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PriceChange.created_at"`)}
+	}
+	if len(pcc.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "PriceChange.item"`)}
+	}
+	return nil
+}
+
+func (pcc *PriceChangeCreate) sqlSave(ctx context.Context) (*PriceChange, error) {
+	if err := pcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pcc.mutation.id = &_node.ID
+	pcc.mutation.done = true
+	return _node, nil
+}
+
+func (pcc *PriceChangeCreate) createSpec() (*PriceChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PriceChange{config: pcc.config}
+		_spec = sqlgraph.NewCreateSpec(pricechange.Table, sqlgraph.NewFieldSpec(pricechange.FieldID, field.TypeInt))
+	)
+	if value, ok := pcc.mutation.Price(); ok {
+		_spec.SetField(pricechange.FieldPrice, field.TypeInt, value)
+		_node.Price = value
+	}
+	if value, ok := pcc.mutation.EffectiveFrom(); ok {
+		_spec.SetField(pricechange.FieldEffectiveFrom, field.TypeTime, value)
+		_node.EffectiveFrom = value
+	}
+	if value, ok := pcc.mutation.EffectiveTo(); ok {
+		_spec.SetField(pricechange.FieldEffectiveTo, field.TypeTime, value)
+		_node.EffectiveTo = &value
+	}
+	if value, ok := pcc.mutation.Status(); ok {
+		_spec.SetField(pricechange.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := pcc.mutation.PreviousPrice(); ok {
+		_spec.SetField(pricechange.FieldPreviousPrice, field.TypeInt, value)
+		_node.PreviousPrice = &value
+	}
+	if value, ok := pcc.mutation.AppliedAt(); ok {
+		_spec.SetField(pricechange.FieldAppliedAt, field.TypeTime, value)
+		_node.AppliedAt = &value
+	}
+	if value, ok := pcc.mutation.EndedAt(); ok {
+		_spec.SetField(pricechange.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := pcc.mutation.CreatedBy(); ok {
+		_spec.SetField(pricechange.FieldCreatedBy, field.TypeInt, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := pcc.mutation.CreatedAt(); ok {
+		_spec.SetField(pricechange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pcc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pricechange.ItemTable,
+			Columns: []string{pricechange.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PriceChangeCreateBulk is the builder for creating many PriceChange entities in bulk.
+type PriceChangeCreateBulk struct {
+	config
+	err      error
+	builders []*PriceChangeCreate
+}
+
+// Save creates the PriceChange entities in the database.
+func (pccb *PriceChangeCreateBulk) Save(ctx context.Context) ([]*PriceChange, error) {
+	if pccb.err != nil {
+		return nil, pccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pccb.builders))
+	nodes := make([]*PriceChange, len(pccb.builders))
+	mutators := make([]Mutator, len(pccb.builders))
+	for i := range pccb.builders {
+		func(i int, root context.Context) {
+			builder := pccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PriceChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pccb *PriceChangeCreateBulk) SaveX(ctx context.Context) []*PriceChange {
+	v, err := pccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pccb *PriceChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := pccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pccb *PriceChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := pccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gin-crud/ent/predicate"
+	"gin-crud/ent/pricechange"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceChangeDelete is the builder for deleting a PriceChange entity.
+type PriceChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *PriceChangeMutation
+}
+
+// Where appends a list predicates to the PriceChangeDelete builder.
+func (pcd *PriceChangeDelete) Where(ps ...predicate.PriceChange) *PriceChangeDelete {
+	pcd.mutation.Where(ps...)
+	return pcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pcd *PriceChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pcd.sqlExec, pcd.mutation, pcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcd *PriceChangeDelete) ExecX(ctx context.Context) int {
+	n, err := pcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pcd *PriceChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pricechange.Table, sqlgraph.NewFieldSpec(pricechange.FieldID, field.TypeInt))
+	if ps := pcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pcd.mutation.done = true
+	return affected, err
+}
+
+// PriceChangeDeleteOne is the builder for deleting a single PriceChange entity.
+type PriceChangeDeleteOne struct {
+	pcd *PriceChangeDelete
+}
+
+// Where appends a list predicates to the PriceChangeDelete builder.
+func (pcdo *PriceChangeDeleteOne) Where(ps ...predicate.PriceChange) *PriceChangeDeleteOne {
+	pcdo.pcd.mutation.Where(ps...)
+	return pcdo
+}
+
+// Exec executes the deletion query.
+func (pcdo *PriceChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := pcdo.pcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pricechange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcdo *PriceChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := pcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gin-crud/ent/item"
+	"gin-crud/ent/predicate"
+	"gin-crud/ent/pricechange"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceChangeQuery is the builder for querying PriceChange entities.
+type PriceChangeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []pricechange.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PriceChange
+	withItem   *ItemQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PriceChangeQuery builder.
+func (pcq *PriceChangeQuery) Where(ps ...predicate.PriceChange) *PriceChangeQuery {
+	pcq.predicates = append(pcq.predicates, ps...)
+	return pcq
+}
+
+// Limit the number of records to be returned by this query.
+func (pcq *PriceChangeQuery) Limit(limit int) *PriceChangeQuery {
+	pcq.ctx.Limit = &limit
+	return pcq
+}
+
+// Offset to start from.
+func (pcq *PriceChangeQuery) Offset(offset int) *PriceChangeQuery {
+	pcq.ctx.Offset = &offset
+	return pcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pcq *PriceChangeQuery) Unique(unique bool) *PriceChangeQuery {
+	pcq.ctx.Unique = &unique
+	return pcq
+}
+
+// Order specifies how the records should be ordered.
+func (pcq *PriceChangeQuery) Order(o ...pricechange.OrderOption) *PriceChangeQuery {
+	pcq.order = append(pcq.order, o...)
+	return pcq
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (pcq *PriceChangeQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: pcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pricechange.Table, pricechange.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pricechange.ItemTable, pricechange.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PriceChange entity from the query.
+// Returns a *NotFoundError when no PriceChange was found.
+func (pcq *PriceChangeQuery) First(ctx context.Context) (*PriceChange, error) {
+	nodes, err := pcq.Limit(1).All(setContextOp(ctx, pcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pricechange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pcq *PriceChangeQuery) FirstX(ctx context.Context) *PriceChange {
+	node, err := pcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PriceChange ID from the query.
+// Returns a *NotFoundError when no PriceChange ID was found.
+func (pcq *PriceChangeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pcq.Limit(1).IDs(setContextOp(ctx, pcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pricechange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pcq *PriceChangeQuery) FirstIDX(ctx context.Context) int {
+	id, err := pcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PriceChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PriceChange entity is found.
+// Returns a *NotFoundError when no PriceChange entities are found.
+func (pcq *PriceChangeQuery) Only(ctx context.Context) (*PriceChange, error) {
+	nodes, err := pcq.Limit(2).All(setContextOp(ctx, pcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pricechange.Label}
+	default:
+		return nil, &NotSingularError{pricechange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pcq *PriceChangeQuery) OnlyX(ctx context.Context) *PriceChange {
+	node, err := pcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PriceChange ID in the query.
+// Returns a *NotSingularError when more than one PriceChange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pcq *PriceChangeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pcq.Limit(2).IDs(setContextOp(ctx, pcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pricechange.Label}
+	default:
+		err = &NotSingularError{pricechange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pcq *PriceChangeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PriceChanges.
+func (pcq *PriceChangeQuery) All(ctx context.Context) ([]*PriceChange, error) {
+	ctx = setContextOp(ctx, pcq.ctx, ent.OpQueryAll)
+	if err := pcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PriceChange, *PriceChangeQuery]()
+	return withInterceptors[[]*PriceChange](ctx, pcq, qr, pcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pcq *PriceChangeQuery) AllX(ctx context.Context) []*PriceChange {
+	nodes, err := pcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PriceChange IDs.
+func (pcq *PriceChangeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pcq.ctx.Unique == nil && pcq.path != nil {
+		pcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pcq.ctx, ent.OpQueryIDs)
+	if err = pcq.Select(pricechange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pcq *PriceChangeQuery) IDsX(ctx context.Context) []int {
+	ids, err := pcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pcq *PriceChangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pcq.ctx, ent.OpQueryCount)
+	if err := pcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pcq, querierCount[*PriceChangeQuery](), pcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pcq *PriceChangeQuery) CountX(ctx context.Context) int {
+	count, err := pcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pcq *PriceChangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pcq.ctx, ent.OpQueryExist)
+	switch _, err := pcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pcq *PriceChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := pcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PriceChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pcq *PriceChangeQuery) Clone() *PriceChangeQuery {
+	if pcq == nil {
+		return nil
+	}
+	return &PriceChangeQuery{
+		config:     pcq.config,
+		ctx:        pcq.ctx.Clone(),
+		order:      append([]pricechange.OrderOption{}, pcq.order...),
+		inters:     append([]Interceptor{}, pcq.inters...),
+		predicates: append([]predicate.PriceChange{}, pcq.predicates...),
+		withItem:   pcq.withItem.Clone(),
+		// clone intermediate query.
+		sql:       pcq.sql.Clone(),
+		path:      pcq.path,
+		modifiers: append([]func(*sql.Selector){}, pcq.modifiers...),
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (pcq *PriceChangeQuery) WithItem(opts ...func(*ItemQuery)) *PriceChangeQuery {
+	query := (&ItemClient{config: pcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pcq.withItem = query
+	return pcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ItemID int64 `json:"item_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PriceChange.Query().
+//		GroupBy(pricechange.FieldItemID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pcq *PriceChangeQuery) GroupBy(field string, fields ...string) *PriceChangeGroupBy {
+	pcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PriceChangeGroupBy{build: pcq}
+	grbuild.flds = &pcq.ctx.Fields
+	grbuild.label = pricechange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ItemID int64 `json:"item_id,omitempty"`
+//	}
+//
+//	client.PriceChange.Query().
+//		Select(pricechange.FieldItemID).
+//		Scan(ctx, &v)
+func (pcq *PriceChangeQuery) Select(fields ...string) *PriceChangeSelect {
+	pcq.ctx.Fields = append(pcq.ctx.Fields, fields...)
+	sbuild := &PriceChangeSelect{PriceChangeQuery: pcq}
+	sbuild.label = pricechange.Label
+	sbuild.flds, sbuild.scan = &pcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PriceChangeSelect configured with the given aggregations.
+func (pcq *PriceChangeQuery) Aggregate(fns ...AggregateFunc) *PriceChangeSelect {
+	return pcq.Select().Aggregate(fns...)
+}
+
+func (pcq *PriceChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pcq.ctx.Fields {
+		if !pricechange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pcq.path != nil {
+		prev, err := pcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pcq.sql = prev
+	}
+	return nil
+}
+
+func (pcq *PriceChangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PriceChange, error) {
+	var (
+		nodes       = []*PriceChange{}
+		_spec       = pcq.querySpec()
+		loadedTypes = [1]bool{
+			pcq.withItem != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PriceChange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PriceChange{config: pcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(pcq.modifiers) > 0 {
+		_spec.Modifiers = pcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pcq.withItem; query != nil {
+		if err := pcq.loadItem(ctx, query, nodes, nil,
+			func(n *PriceChange, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pcq *PriceChangeQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*PriceChange, init func(*PriceChange), assign func(*PriceChange, *Item)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*PriceChange)
+	for i := range nodes {
+		fk := nodes[i].ItemID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pcq *PriceChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pcq.querySpec()
+	if len(pcq.modifiers) > 0 {
+		_spec.Modifiers = pcq.modifiers
+	}
+	_spec.Node.Columns = pcq.ctx.Fields
+	if len(pcq.ctx.Fields) > 0 {
+		_spec.Unique = pcq.ctx.Unique != nil && *pcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pcq.driver, _spec)
+}
+
+func (pcq *PriceChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(pricechange.Table, pricechange.Columns, sqlgraph.NewFieldSpec(pricechange.FieldID, field.TypeInt))
+	_spec.From = pcq.sql
+	if unique := pcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricechange.FieldID)
+		for i := range fields {
+			if fields[i] != pricechange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if pcq.withItem != nil {
+			_spec.Node.AddColumnOnce(pricechange.FieldItemID)
+		}
+	}
+	if ps := pcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pcq *PriceChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pcq.driver.Dialect())
+	t1 := builder.Table(pricechange.Table)
+	columns := pcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = pricechange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pcq.sql != nil {
+		selector = pcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pcq.ctx.Unique != nil && *pcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range pcq.modifiers {
+		m(selector)
+	}
+	for _, p := range pcq.predicates {
+		p(selector)
+	}
+	for _, p := range pcq.order {
+		p(selector)
+	}
+	if offset := pcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pcq *PriceChangeQuery) Modify(modifiers ...func(s *sql.Selector)) *PriceChangeSelect {
+	pcq.modifiers = append(pcq.modifiers, modifiers...)
+	return pcq.Select()
+}
+
+// PriceChangeGroupBy is the group-by builder for PriceChange entities.
+type PriceChangeGroupBy struct {
+	selector
+	build *PriceChangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pcgb *PriceChangeGroupBy) Aggregate(fns ...AggregateFunc) *PriceChangeGroupBy {
+	pcgb.fns = append(pcgb.fns, fns...)
+	return pcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcgb *PriceChangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PriceChangeQuery, *PriceChangeGroupBy](ctx, pcgb.build, pcgb, pcgb.build.inters, v)
+}
+
+func (pcgb *PriceChangeGroupBy) sqlScan(ctx context.Context, root *PriceChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pcgb.fns))
+	for _, fn := range pcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pcgb.flds)+len(pcgb.fns))
+		for _, f := range *pcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PriceChangeSelect is the builder for selecting fields of PriceChange entities.
+type PriceChangeSelect struct {
+	*PriceChangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pcs *PriceChangeSelect) Aggregate(fns ...AggregateFunc) *PriceChangeSelect {
+	pcs.fns = append(pcs.fns, fns...)
+	return pcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcs *PriceChangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcs.ctx, ent.OpQuerySelect)
+	if err := pcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PriceChangeQuery, *PriceChangeSelect](ctx, pcs.PriceChangeQuery, pcs, pcs.inters, v)
+}
+
+func (pcs *PriceChangeSelect) sqlScan(ctx context.Context, root *PriceChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pcs.fns))
+	for _, fn := range pcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pcs *PriceChangeSelect) Modify(modifiers ...func(s *sql.Selector)) *PriceChangeSelect {
+	pcs.modifiers = append(pcs.modifiers, modifiers...)
+	return pcs
+}
@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud/ent/predicate"
+	"gin-crud/ent/pricechange"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceChangeUpdate is the builder for updating PriceChange entities.
+type PriceChangeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PriceChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PriceChangeUpdate builder.
+func (pcu *PriceChangeUpdate) Where(ps ...predicate.PriceChange) *PriceChangeUpdate {
+	pcu.mutation.Where(ps...)
+	return pcu
+}
+
+// SetStatus sets the "status" field.
+func (pcu *PriceChangeUpdate) SetStatus(pr pricechange.Status) *PriceChangeUpdate {
+	pcu.mutation.SetStatus(pr)
+	return pcu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcu *PriceChangeUpdate) SetNillableStatus(pr *pricechange.Status) *PriceChangeUpdate {
+	if pr != nil {
+		pcu.SetStatus(*pr)
+	}
+	return pcu
+}
+
+// SetPreviousPrice sets the "previous_price" field.
+func (pcu *PriceChangeUpdate) SetPreviousPrice(i int) *PriceChangeUpdate {
+	pcu.mutation.ResetPreviousPrice()
+	pcu.mutation.SetPreviousPrice(i)
+	return pcu
+}
+
+// SetNillablePreviousPrice sets the "previous_price" field if the given value is not nil.
+func (pcu *PriceChangeUpdate) SetNillablePreviousPrice(i *int) *PriceChangeUpdate {
+	if i != nil {
+		pcu.SetPreviousPrice(*i)
+	}
+	return pcu
+}
+
+// AddPreviousPrice adds i to the "previous_price" field.
+func (pcu *PriceChangeUpdate) AddPreviousPrice(i int) *PriceChangeUpdate {
+	pcu.mutation.AddPreviousPrice(i)
+	return pcu
+}
+
+// ClearPreviousPrice clears the value of the "previous_price" field.
+func (pcu *PriceChangeUpdate) ClearPreviousPrice() *PriceChangeUpdate {
+	pcu.mutation.ClearPreviousPrice()
+	return pcu
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (pcu *PriceChangeUpdate) SetAppliedAt(t time.Time) *PriceChangeUpdate {
+	pcu.mutation.SetAppliedAt(t)
+	return pcu
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (pcu *PriceChangeUpdate) SetNillableAppliedAt(t *time.Time) *PriceChangeUpdate {
+	if t != nil {
+		pcu.SetAppliedAt(*t)
+	}
+	return pcu
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (pcu *PriceChangeUpdate) ClearAppliedAt() *PriceChangeUpdate {
+	pcu.mutation.ClearAppliedAt()
+	return pcu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (pcu *PriceChangeUpdate) SetEndedAt(t time.Time) *PriceChangeUpdate {
+	pcu.mutation.SetEndedAt(t)
+	return pcu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (pcu *PriceChangeUpdate) SetNillableEndedAt(t *time.Time) *PriceChangeUpdate {
+	if t != nil {
+		pcu.SetEndedAt(*t)
+	}
+	return pcu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (pcu *PriceChangeUpdate) ClearEndedAt() *PriceChangeUpdate {
+	pcu.mutation.ClearEndedAt()
+	return pcu
+}
+
+// Mutation returns the PriceChangeMutation object of the builder.
+func (pcu *PriceChangeUpdate) Mutation() *PriceChangeMutation {
+	return pcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pcu *PriceChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pcu.sqlSave, pcu.mutation, pcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcu *PriceChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := pcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pcu *PriceChangeUpdate) Exec(ctx context.Context) error {
+	_, err := pcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcu *PriceChangeUpdate) ExecX(ctx context.Context) {
+	if err := pcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcu *PriceChangeUpdate) check() error {
+	if v, ok := pcu.mutation.Status(); ok {
+		if err := pricechange.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PriceChange.status": %w`, err)}
+		}
+	}
+	if pcu.mutation.ItemCleared() && len(pcu.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PriceChange.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pcu *PriceChangeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PriceChangeUpdate {
+	pcu.modifiers = append(pcu.modifiers, modifiers...)
+	return pcu
+}
+
+func (pcu *PriceChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pricechange.Table, pricechange.Columns, sqlgraph.NewFieldSpec(pricechange.FieldID, field.TypeInt))
+	if ps := pcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pcu.mutation.EffectiveToCleared() {
+		_spec.ClearField(pricechange.FieldEffectiveTo, field.TypeTime)
+	}
+	if value, ok := pcu.mutation.Status(); ok {
+		_spec.SetField(pricechange.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := pcu.mutation.PreviousPrice(); ok {
+		_spec.SetField(pricechange.FieldPreviousPrice, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.AddedPreviousPrice(); ok {
+		_spec.AddField(pricechange.FieldPreviousPrice, field.TypeInt, value)
+	}
+	if pcu.mutation.PreviousPriceCleared() {
+		_spec.ClearField(pricechange.FieldPreviousPrice, field.TypeInt)
+	}
+	if value, ok := pcu.mutation.AppliedAt(); ok {
+		_spec.SetField(pricechange.FieldAppliedAt, field.TypeTime, value)
+	}
+	if pcu.mutation.AppliedAtCleared() {
+		_spec.ClearField(pricechange.FieldAppliedAt, field.TypeTime)
+	}
+	if value, ok := pcu.mutation.EndedAt(); ok {
+		_spec.SetField(pricechange.FieldEndedAt, field.TypeTime, value)
+	}
+	if pcu.mutation.EndedAtCleared() {
+		_spec.ClearField(pricechange.FieldEndedAt, field.TypeTime)
+	}
+	if pcu.mutation.CreatedByCleared() {
+		_spec.ClearField(pricechange.FieldCreatedBy, field.TypeInt)
+	}
+	_spec.AddModifiers(pcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pcu.mutation.done = true
+	return n, nil
+}
+
+// PriceChangeUpdateOne is the builder for updating a single PriceChange entity.
+type PriceChangeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PriceChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (pcuo *PriceChangeUpdateOne) SetStatus(pr pricechange.Status) *PriceChangeUpdateOne {
+	pcuo.mutation.SetStatus(pr)
+	return pcuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcuo *PriceChangeUpdateOne) SetNillableStatus(pr *pricechange.Status) *PriceChangeUpdateOne {
+	if pr != nil {
+		pcuo.SetStatus(*pr)
+	}
+	return pcuo
+}
+
+// SetPreviousPrice sets the "previous_price" field.
+func (pcuo *PriceChangeUpdateOne) SetPreviousPrice(i int) *PriceChangeUpdateOne {
+	pcuo.mutation.ResetPreviousPrice()
+	pcuo.mutation.SetPreviousPrice(i)
+	return pcuo
+}
+
+// SetNillablePreviousPrice sets the "previous_price" field if the given value is not nil.
+func (pcuo *PriceChangeUpdateOne) SetNillablePreviousPrice(i *int) *PriceChangeUpdateOne {
+	if i != nil {
+		pcuo.SetPreviousPrice(*i)
+	}
+	return pcuo
+}
+
+// AddPreviousPrice adds i to the "previous_price" field.
+func (pcuo *PriceChangeUpdateOne) AddPreviousPrice(i int) *PriceChangeUpdateOne {
+	pcuo.mutation.AddPreviousPrice(i)
+	return pcuo
+}
+
+// ClearPreviousPrice clears the value of the "previous_price" field.
+func (pcuo *PriceChangeUpdateOne) ClearPreviousPrice() *PriceChangeUpdateOne {
+	pcuo.mutation.ClearPreviousPrice()
+	return pcuo
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (pcuo *PriceChangeUpdateOne) SetAppliedAt(t time.Time) *PriceChangeUpdateOne {
+	pcuo.mutation.SetAppliedAt(t)
+	return pcuo
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (pcuo *PriceChangeUpdateOne) SetNillableAppliedAt(t *time.Time) *PriceChangeUpdateOne {
+	if t != nil {
+		pcuo.SetAppliedAt(*t)
+	}
+	return pcuo
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (pcuo *PriceChangeUpdateOne) ClearAppliedAt() *PriceChangeUpdateOne {
+	pcuo.mutation.ClearAppliedAt()
+	return pcuo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (pcuo *PriceChangeUpdateOne) SetEndedAt(t time.Time) *PriceChangeUpdateOne {
+	pcuo.mutation.SetEndedAt(t)
+	return pcuo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (pcuo *PriceChangeUpdateOne) SetNillableEndedAt(t *time.Time) *PriceChangeUpdateOne {
+	if t != nil {
+		pcuo.SetEndedAt(*t)
+	}
+	return pcuo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (pcuo *PriceChangeUpdateOne) ClearEndedAt() *PriceChangeUpdateOne {
+	pcuo.mutation.ClearEndedAt()
+	return pcuo
+}
+
+// Mutation returns the PriceChangeMutation object of the builder.
+func (pcuo *PriceChangeUpdateOne) Mutation() *PriceChangeMutation {
+	return pcuo.mutation
+}
+
+// Where appends a list predicates to the PriceChangeUpdate builder.
+func (pcuo *PriceChangeUpdateOne) Where(ps ...predicate.PriceChange) *PriceChangeUpdateOne {
+	pcuo.mutation.Where(ps...)
+	return pcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pcuo *PriceChangeUpdateOne) Select(field string, fields ...string) *PriceChangeUpdateOne {
+	pcuo.fields = append([]string{field}, fields...)
+	return pcuo
+}
+
+// Save executes the query and returns the updated PriceChange entity.
+func (pcuo *PriceChangeUpdateOne) Save(ctx context.Context) (*PriceChange, error) {
+	return withHooks(ctx, pcuo.sqlSave, pcuo.mutation, pcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcuo *PriceChangeUpdateOne) SaveX(ctx context.Context) *PriceChange {
+	node, err := pcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pcuo *PriceChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := pcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcuo *PriceChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := pcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcuo *PriceChangeUpdateOne) check() error {
+	if v, ok := pcuo.mutation.Status(); ok {
+		if err := pricechange.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PriceChange.status": %w`, err)}
+		}
+	}
+	if pcuo.mutation.ItemCleared() && len(pcuo.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PriceChange.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pcuo *PriceChangeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PriceChangeUpdateOne {
+	pcuo.modifiers = append(pcuo.modifiers, modifiers...)
+	return pcuo
+}
+
+func (pcuo *PriceChangeUpdateOne) sqlSave(ctx context.Context) (_node *PriceChange, err error) {
+	if err := pcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pricechange.Table, pricechange.Columns, sqlgraph.NewFieldSpec(pricechange.FieldID, field.TypeInt))
+	id, ok := pcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PriceChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricechange.FieldID)
+		for _, f := range fields {
+			if !pricechange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pricechange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pcuo.mutation.EffectiveToCleared() {
+		_spec.ClearField(pricechange.FieldEffectiveTo, field.TypeTime)
+	}
+	if value, ok := pcuo.mutation.Status(); ok {
+		_spec.SetField(pricechange.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := pcuo.mutation.PreviousPrice(); ok {
+		_spec.SetField(pricechange.FieldPreviousPrice, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.AddedPreviousPrice(); ok {
+		_spec.AddField(pricechange.FieldPreviousPrice, field.TypeInt, value)
+	}
+	if pcuo.mutation.PreviousPriceCleared() {
+		_spec.ClearField(pricechange.FieldPreviousPrice, field.TypeInt)
+	}
+	if value, ok := pcuo.mutation.AppliedAt(); ok {
+		_spec.SetField(pricechange.FieldAppliedAt, field.TypeTime, value)
+	}
+	if pcuo.mutation.AppliedAtCleared() {
+		_spec.ClearField(pricechange.FieldAppliedAt, field.TypeTime)
+	}
+	if value, ok := pcuo.mutation.EndedAt(); ok {
+		_spec.SetField(pricechange.FieldEndedAt, field.TypeTime, value)
+	}
+	if pcuo.mutation.EndedAtCleared() {
+		_spec.ClearField(pricechange.FieldEndedAt, field.TypeTime)
+	}
+	if pcuo.mutation.CreatedByCleared() {
+		_spec.ClearField(pricechange.FieldCreatedBy, field.TypeInt)
+	}
+	_spec.AddModifiers(pcuo.modifiers...)
+	_node = &PriceChange{config: pcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pcuo.mutation.done = true
+	return _node, nil
+}
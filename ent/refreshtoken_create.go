@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud/ent/refreshtoken"
+	"gin-crud/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RefreshTokenCreate is the builder for creating a RefreshToken entity.
+type RefreshTokenCreate struct {
+	config
+	mutation *RefreshTokenMutation
+	hooks    []Hook
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (rtc *RefreshTokenCreate) SetTokenHash(s string) *RefreshTokenCreate {
+	rtc.mutation.SetTokenHash(s)
+	return rtc
+}
+
+// SetFamilyID sets the "family_id" field.
+func (rtc *RefreshTokenCreate) SetFamilyID(s string) *RefreshTokenCreate {
+	rtc.mutation.SetFamilyID(s)
+	return rtc
+}
+
+// SetAccessJti sets the "access_jti" field.
+func (rtc *RefreshTokenCreate) SetAccessJti(s string) *RefreshTokenCreate {
+	rtc.mutation.SetAccessJti(s)
+	return rtc
+}
+
+// SetUserID sets the "user_id" field.
+func (rtc *RefreshTokenCreate) SetUserID(i int) *RefreshTokenCreate {
+	rtc.mutation.SetUserID(i)
+	return rtc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rtc *RefreshTokenCreate) SetExpiresAt(t time.Time) *RefreshTokenCreate {
+	rtc.mutation.SetExpiresAt(t)
+	return rtc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (rtc *RefreshTokenCreate) SetRevokedAt(t time.Time) *RefreshTokenCreate {
+	rtc.mutation.SetRevokedAt(t)
+	return rtc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (rtc *RefreshTokenCreate) SetNillableRevokedAt(t *time.Time) *RefreshTokenCreate {
+	if t != nil {
+		rtc.SetRevokedAt(*t)
+	}
+	return rtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rtc *RefreshTokenCreate) SetCreatedAt(t time.Time) *RefreshTokenCreate {
+	rtc.mutation.SetCreatedAt(t)
+	return rtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rtc *RefreshTokenCreate) SetNillableCreatedAt(t *time.Time) *RefreshTokenCreate {
+	if t != nil {
+		rtc.SetCreatedAt(*t)
+	}
+	return rtc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rtc *RefreshTokenCreate) SetUser(u *User) *RefreshTokenCreate {
+	return rtc.SetUserID(u.ID)
+}
+
+// Mutation returns the RefreshTokenMutation object of the builder.
+func (rtc *RefreshTokenCreate) Mutation() *RefreshTokenMutation {
+	return rtc.mutation
+}
+
+// Save creates the RefreshToken in the database.
+func (rtc *RefreshTokenCreate) Save(ctx context.Context) (*RefreshToken, error) {
+	rtc.defaults()
+	return withHooks(ctx, rtc.sqlSave, rtc.mutation, rtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rtc *RefreshTokenCreate) SaveX(ctx context.Context) *RefreshToken {
+	v, err := rtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtc *RefreshTokenCreate) Exec(ctx context.Context) error {
+	_, err := rtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtc *RefreshTokenCreate) ExecX(ctx context.Context) {
+	if err := rtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtc *RefreshTokenCreate) defaults() {
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		v := refreshtoken.DefaultCreatedAt()
+		rtc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtc *RefreshTokenCreate) check() error {
+	if _, ok := rtc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "RefreshToken.token_hash"`)}
+	}
+	if v, ok := rtc.mutation.TokenHash(); ok {
+		if err := refreshtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.FamilyID(); !ok {
+		return &ValidationError{Name: "family_id", err: errors.New(`ent: missing required field "RefreshToken.family_id"`)}
+	}
+	if v, ok := rtc.mutation.FamilyID(); ok {
+		if err := refreshtoken.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.family_id": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.AccessJti(); !ok {
+		return &ValidationError{Name: "access_jti", err: errors.New(`ent: missing required field "RefreshToken.access_jti"`)}
+	}
+	if v, ok := rtc.mutation.AccessJti(); ok {
+		if err := refreshtoken.AccessJtiValidator(v); err != nil {
+			return &ValidationError{Name: "access_jti", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.access_jti": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RefreshToken.user_id"`)}
+	}
+	if _, ok := rtc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "RefreshToken.expires_at"`)}
+	}
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RefreshToken.created_at"`)}
+	}
+	if len(rtc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "RefreshToken.user"`)}
+	}
+	return nil
+}
+
+func (rtc *RefreshTokenCreate) sqlSave(ctx context.Context) (*RefreshToken, error) {
+	if err := rtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rtc.mutation.id = &_node.ID
+	rtc.mutation.done = true
+	return _node, nil
+}
+
+func (rtc *RefreshTokenCreate) createSpec() (*RefreshToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RefreshToken{config: rtc.config}
+		_spec = sqlgraph.NewCreateSpec(refreshtoken.Table, sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeInt))
+	)
+	if value, ok := rtc.mutation.TokenHash(); ok {
+		_spec.SetField(refreshtoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := rtc.mutation.FamilyID(); ok {
+		_spec.SetField(refreshtoken.FieldFamilyID, field.TypeString, value)
+		_node.FamilyID = value
+	}
+	if value, ok := rtc.mutation.AccessJti(); ok {
+		_spec.SetField(refreshtoken.FieldAccessJti, field.TypeString, value)
+		_node.AccessJti = value
+	}
+	if value, ok := rtc.mutation.ExpiresAt(); ok {
+		_spec.SetField(refreshtoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := rtc.mutation.RevokedAt(); ok {
+		_spec.SetField(refreshtoken.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := rtc.mutation.CreatedAt(); ok {
+		_spec.SetField(refreshtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rtc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.UserTable,
+			Columns: []string{refreshtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RefreshTokenCreateBulk is the builder for creating many RefreshToken entities in bulk.
+type RefreshTokenCreateBulk struct {
+	config
+	err      error
+	builders []*RefreshTokenCreate
+}
+
+// Save creates the RefreshToken entities in the database.
+func (rtcb *RefreshTokenCreateBulk) Save(ctx context.Context) ([]*RefreshToken, error) {
+	if rtcb.err != nil {
+		return nil, rtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rtcb.builders))
+	nodes := make([]*RefreshToken, len(rtcb.builders))
+	mutators := make([]Mutator, len(rtcb.builders))
+	for i := range rtcb.builders {
+		func(i int, root context.Context) {
+			builder := rtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RefreshTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtcb *RefreshTokenCreateBulk) SaveX(ctx context.Context) []*RefreshToken {
+	v, err := rtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtcb *RefreshTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := rtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtcb *RefreshTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := rtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gin-crud/ent/revokedtoken"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RevokedToken is the model entity for the RevokedToken schema.
+type RevokedToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Jti holds the value of the "jti" field.
+	Jti string `json:"jti,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RevokedToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case revokedtoken.FieldID:
+			values[i] = new(sql.NullInt64)
+		case revokedtoken.FieldJti:
+			values[i] = new(sql.NullString)
+		case revokedtoken.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RevokedToken fields.
+func (rt *RevokedToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case revokedtoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rt.ID = int(value.Int64)
+		case revokedtoken.FieldJti:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field jti", values[i])
+			} else if value.Valid {
+				rt.Jti = value.String
+			}
+		case revokedtoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				rt.ExpiresAt = value.Time
+			}
+		default:
+			rt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RevokedToken.
+// This includes values selected through modifiers, order, etc.
+func (rt *RevokedToken) Value(name string) (ent.Value, error) {
+	return rt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RevokedToken.
+// Note that you need to call RevokedToken.Unwrap() before calling this method if this RevokedToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rt *RevokedToken) Update() *RevokedTokenUpdateOne {
+	return NewRevokedTokenClient(rt.config).UpdateOne(rt)
+}
+
+// Unwrap unwraps the RevokedToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rt *RevokedToken) Unwrap() *RevokedToken {
+	_tx, ok := rt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RevokedToken is not a transactional entity")
+	}
+	rt.config.driver = _tx.drv
+	return rt
+}
+
+// String implements the fmt.Stringer.
+func (rt *RevokedToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("RevokedToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rt.ID))
+	builder.WriteString("jti=")
+	builder.WriteString(rt.Jti)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(rt.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RevokedTokens is a parsable slice of RevokedToken.
+type RevokedTokens []*RevokedToken
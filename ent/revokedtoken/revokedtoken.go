@@ -0,0 +1,60 @@
+// Code generated by ent, DO NOT EDIT.
+
+package revokedtoken
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the revokedtoken type in the database.
+	Label = "revoked_token"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldJti holds the string denoting the jti field in the database.
+	FieldJti = "jti"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the revokedtoken in the database.
+	Table = "revoked_tokens"
+)
+
+// Columns holds all SQL columns for revokedtoken fields.
+var Columns = []string{
+	FieldID,
+	FieldJti,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// JtiValidator is a validator for the "jti" field. It is called by the builders before save.
+	JtiValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the RevokedToken queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByJti orders the results by the jti field.
+func ByJti(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJti, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
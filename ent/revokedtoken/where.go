@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package revokedtoken
+
+import (
+	"gin-crud/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldLTE(FieldID, id))
+}
+
+// Jti applies equality check predicate on the "jti" field. It's identical to JtiEQ.
+func Jti(v string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldEQ(FieldJti, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// JtiEQ applies the EQ predicate on the "jti" field.
+func JtiEQ(v string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldEQ(FieldJti, v))
+}
+
+// JtiNEQ applies the NEQ predicate on the "jti" field.
+func JtiNEQ(v string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldNEQ(FieldJti, v))
+}
+
+// JtiIn applies the In predicate on the "jti" field.
+func JtiIn(vs ...string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldIn(FieldJti, vs...))
+}
+
+// JtiNotIn applies the NotIn predicate on the "jti" field.
+func JtiNotIn(vs ...string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldNotIn(FieldJti, vs...))
+}
+
+// JtiGT applies the GT predicate on the "jti" field.
+func JtiGT(v string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldGT(FieldJti, v))
+}
+
+// JtiGTE applies the GTE predicate on the "jti" field.
+func JtiGTE(v string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldGTE(FieldJti, v))
+}
+
+// JtiLT applies the LT predicate on the "jti" field.
+func JtiLT(v string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldLT(FieldJti, v))
+}
+
+// JtiLTE applies the LTE predicate on the "jti" field.
+func JtiLTE(v string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldLTE(FieldJti, v))
+}
+
+// JtiContains applies the Contains predicate on the "jti" field.
+func JtiContains(v string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldContains(FieldJti, v))
+}
+
+// JtiHasPrefix applies the HasPrefix predicate on the "jti" field.
+func JtiHasPrefix(v string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldHasPrefix(FieldJti, v))
+}
+
+// JtiHasSuffix applies the HasSuffix predicate on the "jti" field.
+func JtiHasSuffix(v string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldHasSuffix(FieldJti, v))
+}
+
+// JtiEqualFold applies the EqualFold predicate on the "jti" field.
+func JtiEqualFold(v string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldEqualFold(FieldJti, v))
+}
+
+// JtiContainsFold applies the ContainsFold predicate on the "jti" field.
+func JtiContainsFold(v string) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldContainsFold(FieldJti, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RevokedToken) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RevokedToken) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RevokedToken) predicate.RevokedToken {
+	return predicate.RevokedToken(sql.NotPredicates(p))
+}
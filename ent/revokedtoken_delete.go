@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gin-crud/ent/predicate"
+	"gin-crud/ent/revokedtoken"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RevokedTokenDelete is the builder for deleting a RevokedToken entity.
+type RevokedTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *RevokedTokenMutation
+}
+
+// Where appends a list predicates to the RevokedTokenDelete builder.
+func (rtd *RevokedTokenDelete) Where(ps ...predicate.RevokedToken) *RevokedTokenDelete {
+	rtd.mutation.Where(ps...)
+	return rtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rtd *RevokedTokenDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rtd.sqlExec, rtd.mutation, rtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtd *RevokedTokenDelete) ExecX(ctx context.Context) int {
+	n, err := rtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rtd *RevokedTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(revokedtoken.Table, sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeInt))
+	if ps := rtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rtd.mutation.done = true
+	return affected, err
+}
+
+// RevokedTokenDeleteOne is the builder for deleting a single RevokedToken entity.
+type RevokedTokenDeleteOne struct {
+	rtd *RevokedTokenDelete
+}
+
+// Where appends a list predicates to the RevokedTokenDelete builder.
+func (rtdo *RevokedTokenDeleteOne) Where(ps ...predicate.RevokedToken) *RevokedTokenDeleteOne {
+	rtdo.rtd.mutation.Where(ps...)
+	return rtdo
+}
+
+// Exec executes the deletion query.
+func (rtdo *RevokedTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := rtdo.rtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{revokedtoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtdo *RevokedTokenDeleteOne) ExecX(ctx context.Context) {
+	if err := rtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
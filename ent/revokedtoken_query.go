@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gin-crud/ent/predicate"
+	"gin-crud/ent/revokedtoken"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RevokedTokenQuery is the builder for querying RevokedToken entities.
+type RevokedTokenQuery struct {
+	config
+	ctx        *QueryContext
+	order      []revokedtoken.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RevokedToken
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RevokedTokenQuery builder.
+func (rtq *RevokedTokenQuery) Where(ps ...predicate.RevokedToken) *RevokedTokenQuery {
+	rtq.predicates = append(rtq.predicates, ps...)
+	return rtq
+}
+
+// Limit the number of records to be returned by this query.
+func (rtq *RevokedTokenQuery) Limit(limit int) *RevokedTokenQuery {
+	rtq.ctx.Limit = &limit
+	return rtq
+}
+
+// Offset to start from.
+func (rtq *RevokedTokenQuery) Offset(offset int) *RevokedTokenQuery {
+	rtq.ctx.Offset = &offset
+	return rtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rtq *RevokedTokenQuery) Unique(unique bool) *RevokedTokenQuery {
+	rtq.ctx.Unique = &unique
+	return rtq
+}
+
+// Order specifies how the records should be ordered.
+func (rtq *RevokedTokenQuery) Order(o ...revokedtoken.OrderOption) *RevokedTokenQuery {
+	rtq.order = append(rtq.order, o...)
+	return rtq
+}
+
+// First returns the first RevokedToken entity from the query.
+// Returns a *NotFoundError when no RevokedToken was found.
+func (rtq *RevokedTokenQuery) First(ctx context.Context) (*RevokedToken, error) {
+	nodes, err := rtq.Limit(1).All(setContextOp(ctx, rtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{revokedtoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rtq *RevokedTokenQuery) FirstX(ctx context.Context) *RevokedToken {
+	node, err := rtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RevokedToken ID from the query.
+// Returns a *NotFoundError when no RevokedToken ID was found.
+func (rtq *RevokedTokenQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rtq.Limit(1).IDs(setContextOp(ctx, rtq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{revokedtoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rtq *RevokedTokenQuery) FirstIDX(ctx context.Context) int {
+	id, err := rtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RevokedToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RevokedToken entity is found.
+// Returns a *NotFoundError when no RevokedToken entities are found.
+func (rtq *RevokedTokenQuery) Only(ctx context.Context) (*RevokedToken, error) {
+	nodes, err := rtq.Limit(2).All(setContextOp(ctx, rtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{revokedtoken.Label}
+	default:
+		return nil, &NotSingularError{revokedtoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rtq *RevokedTokenQuery) OnlyX(ctx context.Context) *RevokedToken {
+	node, err := rtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RevokedToken ID in the query.
+// Returns a *NotSingularError when more than one RevokedToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rtq *RevokedTokenQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rtq.Limit(2).IDs(setContextOp(ctx, rtq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{revokedtoken.Label}
+	default:
+		err = &NotSingularError{revokedtoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rtq *RevokedTokenQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RevokedTokens.
+func (rtq *RevokedTokenQuery) All(ctx context.Context) ([]*RevokedToken, error) {
+	ctx = setContextOp(ctx, rtq.ctx, ent.OpQueryAll)
+	if err := rtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RevokedToken, *RevokedTokenQuery]()
+	return withInterceptors[[]*RevokedToken](ctx, rtq, qr, rtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rtq *RevokedTokenQuery) AllX(ctx context.Context) []*RevokedToken {
+	nodes, err := rtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RevokedToken IDs.
+func (rtq *RevokedTokenQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rtq.ctx.Unique == nil && rtq.path != nil {
+		rtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rtq.ctx, ent.OpQueryIDs)
+	if err = rtq.Select(revokedtoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rtq *RevokedTokenQuery) IDsX(ctx context.Context) []int {
+	ids, err := rtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rtq *RevokedTokenQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rtq.ctx, ent.OpQueryCount)
+	if err := rtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rtq, querierCount[*RevokedTokenQuery](), rtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rtq *RevokedTokenQuery) CountX(ctx context.Context) int {
+	count, err := rtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rtq *RevokedTokenQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rtq.ctx, ent.OpQueryExist)
+	switch _, err := rtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rtq *RevokedTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := rtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RevokedTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rtq *RevokedTokenQuery) Clone() *RevokedTokenQuery {
+	if rtq == nil {
+		return nil
+	}
+	return &RevokedTokenQuery{
+		config:     rtq.config,
+		ctx:        rtq.ctx.Clone(),
+		order:      append([]revokedtoken.OrderOption{}, rtq.order...),
+		inters:     append([]Interceptor{}, rtq.inters...),
+		predicates: append([]predicate.RevokedToken{}, rtq.predicates...),
+		// clone intermediate query.
+		sql:       rtq.sql.Clone(),
+		path:      rtq.path,
+		modifiers: append([]func(*sql.Selector){}, rtq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Jti string `json:"jti,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RevokedToken.Query().
+//		GroupBy(revokedtoken.FieldJti).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rtq *RevokedTokenQuery) GroupBy(field string, fields ...string) *RevokedTokenGroupBy {
+	rtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RevokedTokenGroupBy{build: rtq}
+	grbuild.flds = &rtq.ctx.Fields
+	grbuild.label = revokedtoken.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Jti string `json:"jti,omitempty"`
+//	}
+//
+//	client.RevokedToken.Query().
+//		Select(revokedtoken.FieldJti).
+//		Scan(ctx, &v)
+func (rtq *RevokedTokenQuery) Select(fields ...string) *RevokedTokenSelect {
+	rtq.ctx.Fields = append(rtq.ctx.Fields, fields...)
+	sbuild := &RevokedTokenSelect{RevokedTokenQuery: rtq}
+	sbuild.label = revokedtoken.Label
+	sbuild.flds, sbuild.scan = &rtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RevokedTokenSelect configured with the given aggregations.
+func (rtq *RevokedTokenQuery) Aggregate(fns ...AggregateFunc) *RevokedTokenSelect {
+	return rtq.Select().Aggregate(fns...)
+}
+
+func (rtq *RevokedTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rtq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rtq.ctx.Fields {
+		if !revokedtoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rtq.path != nil {
+		prev, err := rtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rtq.sql = prev
+	}
+	return nil
+}
+
+func (rtq *RevokedTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RevokedToken, error) {
+	var (
+		nodes = []*RevokedToken{}
+		_spec = rtq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RevokedToken).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RevokedToken{config: rtq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(rtq.modifiers) > 0 {
+		_spec.Modifiers = rtq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rtq *RevokedTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rtq.querySpec()
+	if len(rtq.modifiers) > 0 {
+		_spec.Modifiers = rtq.modifiers
+	}
+	_spec.Node.Columns = rtq.ctx.Fields
+	if len(rtq.ctx.Fields) > 0 {
+		_spec.Unique = rtq.ctx.Unique != nil && *rtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rtq.driver, _spec)
+}
+
+func (rtq *RevokedTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(revokedtoken.Table, revokedtoken.Columns, sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeInt))
+	_spec.From = rtq.sql
+	if unique := rtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, revokedtoken.FieldID)
+		for i := range fields {
+			if fields[i] != revokedtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rtq *RevokedTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rtq.driver.Dialect())
+	t1 := builder.Table(revokedtoken.Table)
+	columns := rtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = revokedtoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rtq.sql != nil {
+		selector = rtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rtq.ctx.Unique != nil && *rtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rtq.modifiers {
+		m(selector)
+	}
+	for _, p := range rtq.predicates {
+		p(selector)
+	}
+	for _, p := range rtq.order {
+		p(selector)
+	}
+	if offset := rtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rtq *RevokedTokenQuery) Modify(modifiers ...func(s *sql.Selector)) *RevokedTokenSelect {
+	rtq.modifiers = append(rtq.modifiers, modifiers...)
+	return rtq.Select()
+}
+
+// RevokedTokenGroupBy is the group-by builder for RevokedToken entities.
+type RevokedTokenGroupBy struct {
+	selector
+	build *RevokedTokenQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rtgb *RevokedTokenGroupBy) Aggregate(fns ...AggregateFunc) *RevokedTokenGroupBy {
+	rtgb.fns = append(rtgb.fns, fns...)
+	return rtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rtgb *RevokedTokenGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RevokedTokenQuery, *RevokedTokenGroupBy](ctx, rtgb.build, rtgb, rtgb.build.inters, v)
+}
+
+func (rtgb *RevokedTokenGroupBy) sqlScan(ctx context.Context, root *RevokedTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rtgb.fns))
+	for _, fn := range rtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rtgb.flds)+len(rtgb.fns))
+		for _, f := range *rtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RevokedTokenSelect is the builder for selecting fields of RevokedToken entities.
+type RevokedTokenSelect struct {
+	*RevokedTokenQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rts *RevokedTokenSelect) Aggregate(fns ...AggregateFunc) *RevokedTokenSelect {
+	rts.fns = append(rts.fns, fns...)
+	return rts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rts *RevokedTokenSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rts.ctx, ent.OpQuerySelect)
+	if err := rts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RevokedTokenQuery, *RevokedTokenSelect](ctx, rts.RevokedTokenQuery, rts, rts.inters, v)
+}
+
+func (rts *RevokedTokenSelect) sqlScan(ctx context.Context, root *RevokedTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rts.fns))
+	for _, fn := range rts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rts *RevokedTokenSelect) Modify(modifiers ...func(s *sql.Selector)) *RevokedTokenSelect {
+	rts.modifiers = append(rts.modifiers, modifiers...)
+	return rts
+}
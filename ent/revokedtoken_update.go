@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud/ent/predicate"
+	"gin-crud/ent/revokedtoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RevokedTokenUpdate is the builder for updating RevokedToken entities.
+type RevokedTokenUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RevokedTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RevokedTokenUpdate builder.
+func (rtu *RevokedTokenUpdate) Where(ps ...predicate.RevokedToken) *RevokedTokenUpdate {
+	rtu.mutation.Where(ps...)
+	return rtu
+}
+
+// SetJti sets the "jti" field.
+func (rtu *RevokedTokenUpdate) SetJti(s string) *RevokedTokenUpdate {
+	rtu.mutation.SetJti(s)
+	return rtu
+}
+
+// SetNillableJti sets the "jti" field if the given value is not nil.
+func (rtu *RevokedTokenUpdate) SetNillableJti(s *string) *RevokedTokenUpdate {
+	if s != nil {
+		rtu.SetJti(*s)
+	}
+	return rtu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rtu *RevokedTokenUpdate) SetExpiresAt(t time.Time) *RevokedTokenUpdate {
+	rtu.mutation.SetExpiresAt(t)
+	return rtu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rtu *RevokedTokenUpdate) SetNillableExpiresAt(t *time.Time) *RevokedTokenUpdate {
+	if t != nil {
+		rtu.SetExpiresAt(*t)
+	}
+	return rtu
+}
+
+// Mutation returns the RevokedTokenMutation object of the builder.
+func (rtu *RevokedTokenUpdate) Mutation() *RevokedTokenMutation {
+	return rtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rtu *RevokedTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rtu.sqlSave, rtu.mutation, rtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtu *RevokedTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := rtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rtu *RevokedTokenUpdate) Exec(ctx context.Context) error {
+	_, err := rtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtu *RevokedTokenUpdate) ExecX(ctx context.Context) {
+	if err := rtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtu *RevokedTokenUpdate) check() error {
+	if v, ok := rtu.mutation.Jti(); ok {
+		if err := revokedtoken.JtiValidator(v); err != nil {
+			return &ValidationError{Name: "jti", err: fmt.Errorf(`ent: validator failed for field "RevokedToken.jti": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rtu *RevokedTokenUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RevokedTokenUpdate {
+	rtu.modifiers = append(rtu.modifiers, modifiers...)
+	return rtu
+}
+
+func (rtu *RevokedTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(revokedtoken.Table, revokedtoken.Columns, sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeInt))
+	if ps := rtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtu.mutation.Jti(); ok {
+		_spec.SetField(revokedtoken.FieldJti, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.ExpiresAt(); ok {
+		_spec.SetField(revokedtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(rtu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{revokedtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rtu.mutation.done = true
+	return n, nil
+}
+
+// RevokedTokenUpdateOne is the builder for updating a single RevokedToken entity.
+type RevokedTokenUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RevokedTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetJti sets the "jti" field.
+func (rtuo *RevokedTokenUpdateOne) SetJti(s string) *RevokedTokenUpdateOne {
+	rtuo.mutation.SetJti(s)
+	return rtuo
+}
+
+// SetNillableJti sets the "jti" field if the given value is not nil.
+func (rtuo *RevokedTokenUpdateOne) SetNillableJti(s *string) *RevokedTokenUpdateOne {
+	if s != nil {
+		rtuo.SetJti(*s)
+	}
+	return rtuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rtuo *RevokedTokenUpdateOne) SetExpiresAt(t time.Time) *RevokedTokenUpdateOne {
+	rtuo.mutation.SetExpiresAt(t)
+	return rtuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rtuo *RevokedTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *RevokedTokenUpdateOne {
+	if t != nil {
+		rtuo.SetExpiresAt(*t)
+	}
+	return rtuo
+}
+
+// Mutation returns the RevokedTokenMutation object of the builder.
+func (rtuo *RevokedTokenUpdateOne) Mutation() *RevokedTokenMutation {
+	return rtuo.mutation
+}
+
+// Where appends a list predicates to the RevokedTokenUpdate builder.
+func (rtuo *RevokedTokenUpdateOne) Where(ps ...predicate.RevokedToken) *RevokedTokenUpdateOne {
+	rtuo.mutation.Where(ps...)
+	return rtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rtuo *RevokedTokenUpdateOne) Select(field string, fields ...string) *RevokedTokenUpdateOne {
+	rtuo.fields = append([]string{field}, fields...)
+	return rtuo
+}
+
+// Save executes the query and returns the updated RevokedToken entity.
+func (rtuo *RevokedTokenUpdateOne) Save(ctx context.Context) (*RevokedToken, error) {
+	return withHooks(ctx, rtuo.sqlSave, rtuo.mutation, rtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtuo *RevokedTokenUpdateOne) SaveX(ctx context.Context) *RevokedToken {
+	node, err := rtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rtuo *RevokedTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := rtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtuo *RevokedTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := rtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtuo *RevokedTokenUpdateOne) check() error {
+	if v, ok := rtuo.mutation.Jti(); ok {
+		if err := revokedtoken.JtiValidator(v); err != nil {
+			return &ValidationError{Name: "jti", err: fmt.Errorf(`ent: validator failed for field "RevokedToken.jti": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rtuo *RevokedTokenUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RevokedTokenUpdateOne {
+	rtuo.modifiers = append(rtuo.modifiers, modifiers...)
+	return rtuo
+}
+
+func (rtuo *RevokedTokenUpdateOne) sqlSave(ctx context.Context) (_node *RevokedToken, err error) {
+	if err := rtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(revokedtoken.Table, revokedtoken.Columns, sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeInt))
+	id, ok := rtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RevokedToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, revokedtoken.FieldID)
+		for _, f := range fields {
+			if !revokedtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != revokedtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtuo.mutation.Jti(); ok {
+		_spec.SetField(revokedtoken.FieldJti, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(revokedtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(rtuo.modifiers...)
+	_node = &RevokedToken{config: rtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{revokedtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rtuo.mutation.done = true
+	return _node, nil
+}
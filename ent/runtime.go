@@ -2,30 +2,4 @@
 
 package ent
 
-import (
-	"gin-crud/ent/item"
-	"gin-crud/ent/schema"
-	"gin-crud/ent/user"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	itemFields := schema.Item{}.Fields()
-	_ = itemFields
-	// itemDescName is the schema descriptor for name field.
-	itemDescName := itemFields[1].Descriptor()
-	// item.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	item.NameValidator = itemDescName.Validators[0].(func(string) error)
-	userFields := schema.User{}.Fields()
-	_ = userFields
-	// userDescUsername is the schema descriptor for username field.
-	userDescUsername := userFields[1].Descriptor()
-	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
-	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
-	// userDescPassword is the schema descriptor for password field.
-	userDescPassword := userFields[2].Descriptor()
-	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
-	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
-}
+// The schema-stitching logic is generated in gin-crud/ent/runtime/runtime.go
@@ -2,7 +2,143 @@
 
 package runtime
 
-// The schema-stitching logic is generated in gin-crud/ent/runtime.go
+import (
+	"gin-crud/ent/auditentry"
+	"gin-crud/ent/category"
+	"gin-crud/ent/item"
+	"gin-crud/ent/itemimage"
+	"gin-crud/ent/itemrevision"
+	"gin-crud/ent/pricechange"
+	"gin-crud/ent/refreshtoken"
+	"gin-crud/ent/revokedtoken"
+	"gin-crud/ent/schema"
+	"gin-crud/ent/tag"
+	"gin-crud/ent/user"
+	"time"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	auditentryFields := schema.AuditEntry{}.Fields()
+	_ = auditentryFields
+	// auditentryDescEntityType is the schema descriptor for entity_type field.
+	auditentryDescEntityType := auditentryFields[1].Descriptor()
+	// auditentry.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	auditentry.EntityTypeValidator = auditentryDescEntityType.Validators[0].(func(string) error)
+	// auditentryDescCreatedAt is the schema descriptor for created_at field.
+	auditentryDescCreatedAt := auditentryFields[6].Descriptor()
+	// auditentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditentry.DefaultCreatedAt = auditentryDescCreatedAt.Default.(func() time.Time)
+	categoryFields := schema.Category{}.Fields()
+	_ = categoryFields
+	// categoryDescName is the schema descriptor for name field.
+	categoryDescName := categoryFields[1].Descriptor()
+	// category.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	category.NameValidator = categoryDescName.Validators[0].(func(string) error)
+	// categoryDescSlug is the schema descriptor for slug field.
+	categoryDescSlug := categoryFields[2].Descriptor()
+	// category.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	category.SlugValidator = categoryDescSlug.Validators[0].(func(string) error)
+	itemMixin := schema.Item{}.Mixin()
+	itemMixinHooks0 := itemMixin[0].Hooks()
+	item.Hooks[0] = itemMixinHooks0[0]
+	itemMixinInters0 := itemMixin[0].Interceptors()
+	item.Interceptors[0] = itemMixinInters0[0]
+	itemFields := schema.Item{}.Fields()
+	_ = itemFields
+	// itemDescName is the schema descriptor for name field.
+	itemDescName := itemFields[1].Descriptor()
+	// item.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	item.NameValidator = itemDescName.Validators[0].(func(string) error)
+	// itemDescCurrency is the schema descriptor for currency field.
+	itemDescCurrency := itemFields[3].Descriptor()
+	// item.DefaultCurrency holds the default value on creation for the currency field.
+	item.DefaultCurrency = itemDescCurrency.Default.(string)
+	// item.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	item.CurrencyValidator = itemDescCurrency.Validators[0].(func(string) error)
+	// itemDescStock is the schema descriptor for stock field.
+	itemDescStock := itemFields[5].Descriptor()
+	// item.DefaultStock holds the default value on creation for the stock field.
+	item.DefaultStock = itemDescStock.Default.(int)
+	// item.StockValidator is a validator for the "stock" field. It is called by the builders before save.
+	item.StockValidator = itemDescStock.Validators[0].(func(int) error)
+	// itemDescVersion is the schema descriptor for version field.
+	itemDescVersion := itemFields[7].Descriptor()
+	// item.DefaultVersion holds the default value on creation for the version field.
+	item.DefaultVersion = itemDescVersion.Default.(int)
+	itemimageFields := schema.ItemImage{}.Fields()
+	_ = itemimageFields
+	// itemimageDescKey is the schema descriptor for key field.
+	itemimageDescKey := itemimageFields[1].Descriptor()
+	// itemimage.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	itemimage.KeyValidator = itemimageDescKey.Validators[0].(func(string) error)
+	// itemimageDescThumbnailKey is the schema descriptor for thumbnail_key field.
+	itemimageDescThumbnailKey := itemimageFields[2].Descriptor()
+	// itemimage.ThumbnailKeyValidator is a validator for the "thumbnail_key" field. It is called by the builders before save.
+	itemimage.ThumbnailKeyValidator = itemimageDescThumbnailKey.Validators[0].(func(string) error)
+	// itemimageDescCreatedAt is the schema descriptor for created_at field.
+	itemimageDescCreatedAt := itemimageFields[9].Descriptor()
+	// itemimage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	itemimage.DefaultCreatedAt = itemimageDescCreatedAt.Default.(func() time.Time)
+	itemrevisionFields := schema.ItemRevision{}.Fields()
+	_ = itemrevisionFields
+	// itemrevisionDescCurrency is the schema descriptor for currency field.
+	itemrevisionDescCurrency := itemrevisionFields[4].Descriptor()
+	// itemrevision.DefaultCurrency holds the default value on creation for the currency field.
+	itemrevision.DefaultCurrency = itemrevisionDescCurrency.Default.(string)
+	// itemrevisionDescCreatedAt is the schema descriptor for created_at field.
+	itemrevisionDescCreatedAt := itemrevisionFields[8].Descriptor()
+	// itemrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	itemrevision.DefaultCreatedAt = itemrevisionDescCreatedAt.Default.(func() time.Time)
+	pricechangeFields := schema.PriceChange{}.Fields()
+	_ = pricechangeFields
+	// pricechangeDescCreatedAt is the schema descriptor for created_at field.
+	pricechangeDescCreatedAt := pricechangeFields[9].Descriptor()
+	// pricechange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pricechange.DefaultCreatedAt = pricechangeDescCreatedAt.Default.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	refreshtokenDescTokenHash := refreshtokenFields[0].Descriptor()
+	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	refreshtoken.TokenHashValidator = refreshtokenDescTokenHash.Validators[0].(func(string) error)
+	// refreshtokenDescFamilyID is the schema descriptor for family_id field.
+	refreshtokenDescFamilyID := refreshtokenFields[1].Descriptor()
+	// refreshtoken.FamilyIDValidator is a validator for the "family_id" field. It is called by the builders before save.
+	refreshtoken.FamilyIDValidator = refreshtokenDescFamilyID.Validators[0].(func(string) error)
+	// refreshtokenDescAccessJti is the schema descriptor for access_jti field.
+	refreshtokenDescAccessJti := refreshtokenFields[2].Descriptor()
+	// refreshtoken.AccessJtiValidator is a validator for the "access_jti" field. It is called by the builders before save.
+	refreshtoken.AccessJtiValidator = refreshtokenDescAccessJti.Validators[0].(func(string) error)
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[6].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	revokedtokenFields := schema.RevokedToken{}.Fields()
+	_ = revokedtokenFields
+	// revokedtokenDescJti is the schema descriptor for jti field.
+	revokedtokenDescJti := revokedtokenFields[0].Descriptor()
+	// revokedtoken.JtiValidator is a validator for the "jti" field. It is called by the builders before save.
+	revokedtoken.JtiValidator = revokedtokenDescJti.Validators[0].(func(string) error)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[1].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
+	// userDescPassword is the schema descriptor for password field.
+	userDescPassword := userFields[2].Descriptor()
+	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+}
 
 const (
 	Version = "v0.14.4"                                         // Version of ent codegen.
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEntry records a single change made to an item or a user
+type AuditEntry struct {
+	ent.Schema
+}
+
+func (AuditEntry) Fields() []ent.Field {
+	return []ent.Field{
+		// actor_id is the user who made the change, empty for system jobs
+		field.Int("actor_id").
+			Optional().
+			Nillable(),
+		field.String("entity_type").
+			NotEmpty(),
+		field.Int64("entity_id"),
+		field.Enum("operation").
+			Values("create", "update", "delete"),
+		// before and after only hold the fields that changed
+		field.JSON("before", map[string]any{}).
+			Optional(),
+		field.JSON("after", map[string]any{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id"),
+		index.Fields("actor_id"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"regexp"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// slugPattern matches lowercase, dash-separated slugs such as "home-office"
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// Category groups items in a tree. A category with no parent is a root.
+type Category struct {
+	ent.Schema
+}
+
+func (Category) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.String("name").
+			NotEmpty(),
+		// slug identifies the category in URLs and filters
+		field.String("slug").
+			Unique().
+			Match(slugPattern),
+		field.Int("parent_id").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (Category) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("children", Category.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		edge.To("items", Item.Type),
+	}
+}
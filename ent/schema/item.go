@@ -1,7 +1,11 @@
 package schema
 
 import (
+	"gin-crud/internal/money"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -9,15 +13,56 @@ type Item struct {
 	ent.Schema
 }
 
+func (Item) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 func (Item) Fields() []ent.Field {
 	return []ent.Field{
-		field.Int("id").
+		field.Int64("id").
 			Unique().
 			Immutable(),
 		field.String("name").
 			NotEmpty(),
+		// price is in the minor unit of currency, e.g. cents for USD
 		field.Int("price"),
+		field.String("currency").
+			Default(money.DefaultCurrency).
+			Validate(money.ValidateCurrency),
 		field.String("description").
 			Optional(),
+		field.Int("stock").
+			NonNegative().
+			Default(0),
+		field.Int("owner_id").
+			Optional(),
+		// version is bumped on every write and exposed as the ETag
+		field.Int("version").
+			Default(1),
+	}
+}
+
+func (Item) Edges() []ent.Edge {
+	return []ent.Edge{
+		// owner is the user who created the item
+		edge.From("owner", User.Type).
+			Ref("items").
+			Field("owner_id").
+			Unique(),
+		// revisions are removed together with the item when it is purged
+		edge.To("revisions", ItemRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// images are removed together with the item when it is purged
+		edge.To("images", ItemImage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// price_changes are removed together with the item when it is purged
+		edge.To("price_changes", PriceChange.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("categories", Category.Type).
+			Ref("items"),
+		edge.From("tags", Tag.Type).
+			Ref("items"),
 	}
 }
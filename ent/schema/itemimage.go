@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ItemImage is an uploaded product photo and its thumbnail.
+// The files live in the configured storage backend under key and thumbnail_key.
+type ItemImage struct {
+	ent.Schema
+}
+
+func (ItemImage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("item_id").
+			Immutable(),
+		field.String("key").
+			NotEmpty().
+			Immutable().
+			Sensitive(),
+		field.String("thumbnail_key").
+			NotEmpty().
+			Immutable().
+			Sensitive(),
+		// url and thumbnail_url are where clients download the files
+		field.String("url").
+			Immutable(),
+		field.String("thumbnail_url").
+			Immutable(),
+		field.String("content_type").
+			Immutable(),
+		field.Int64("size").
+			Immutable(),
+		field.Int("width").
+			Immutable(),
+		field.Int("height").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (ItemImage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Ref("images").
+			Field("item_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"gin-crud/internal/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ItemRevision is a full snapshot of an item at one of its versions
+type ItemRevision struct {
+	ent.Schema
+}
+
+func (ItemRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("item_id").
+			Immutable(),
+		// revision is the item version the snapshot was taken at
+		field.Int("revision").
+			Immutable(),
+		field.String("name").
+			Immutable(),
+		field.Int("price").
+			Immutable(),
+		field.String("currency").
+			Default(money.DefaultCurrency).
+			Immutable(),
+		field.String("description").
+			Optional().
+			Immutable(),
+		field.Int("stock").
+			Immutable(),
+		field.Int("actor_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (ItemRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Ref("revisions").
+			Field("item_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (ItemRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "revision").
+			Unique(),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PriceChange is a price scheduled for an item over a time window.
+// The scheduler job sets the price at effective_from and restores the
+// previous one at effective_to; without effective_to the change is permanent.
+type PriceChange struct {
+	ent.Schema
+}
+
+func (PriceChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("item_id").
+			Immutable(),
+		// price is in the minor unit of the item's currency
+		field.Int("price").
+			Immutable(),
+		field.Time("effective_from").
+			Immutable(),
+		field.Time("effective_to").
+			Optional().
+			Nillable().
+			Immutable(),
+		// status moves scheduled -> active -> ended, or to cancelled before it starts.
+		// A change whose window passed before it could be applied ends without applying.
+		field.Enum("status").
+			Values("scheduled", "active", "ended", "cancelled").
+			Default("scheduled"),
+		// previous_price is the price the item had when the change was applied
+		field.Int("previous_price").
+			Optional().
+			Nillable(),
+		field.Time("applied_at").
+			Optional().
+			Nillable(),
+		field.Time("ended_at").
+			Optional().
+			Nillable(),
+		field.Int("created_by").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (PriceChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Ref("price_changes").
+			Field("item_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (PriceChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "effective_from"),
+		index.Fields("item_id"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RefreshToken holds a rotating refresh token.
+// Only the SHA-256 hash of the token is stored.
+type RefreshToken struct {
+	ent.Schema
+}
+
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			NotEmpty().
+			Unique().
+			Sensitive(),
+		// family_id is shared by every token rotated from the same login
+		field.String("family_id").
+			NotEmpty(),
+		// access_jti is the jti of the access token issued together with this token
+		field.String("access_jti").
+			NotEmpty(),
+		field.Int("user_id"),
+		field.Time("expires_at"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("refresh_tokens").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("family_id"),
+	}
+}
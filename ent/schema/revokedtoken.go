@@ -0,0 +1,21 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// RevokedToken records the jti of an access token that must no longer be accepted.
+// Rows can be removed once expires_at has passed.
+type RevokedToken struct {
+	ent.Schema
+}
+
+func (RevokedToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("jti").
+			NotEmpty().
+			Unique(),
+		field.Time("expires_at"),
+	}
+}
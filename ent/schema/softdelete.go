@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gen "gin-crud/ent"
+	"gin-crud/ent/hook"
+	"gin-crud/ent/intercept"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SoftDeleteMixin adds a deleted_at field, turns deletes into updates of that
+// field and hides soft-deleted rows from every query.
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+type softDeleteKey struct{}
+
+// SkipSoftDelete returns a context that sees soft-deleted rows and makes
+// deletes remove rows for good
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, softDeleteKey{}, true)
+}
+
+func skipSoftDelete(ctx context.Context) bool {
+	skip, _ := ctx.Value(softDeleteKey{}).(bool)
+	return skip
+}
+
+func (d SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if !skipSoftDelete(ctx) {
+				d.P(q)
+			}
+			return nil
+		}),
+	}
+}
+
+func (d SoftDeleteMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					if skipSoftDelete(ctx) {
+						return next.Mutate(ctx, m)
+					}
+					mx, ok := m.(interface {
+						SetOp(ent.Op)
+						Client() *gen.Client
+						SetDeletedAt(time.Time)
+						WhereP(...func(*sql.Selector))
+					})
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type %T", m)
+					}
+					// Already deleted rows are not deleted again
+					d.P(mx)
+					mx.SetOp(ent.OpUpdate)
+					mx.SetDeletedAt(time.Now())
+					// Stay in the transaction the delete runs in, if any
+					client := mx.Client()
+					if tx := gen.TxFromContext(ctx); tx != nil {
+						client = tx.Client()
+					}
+					return client.Mutate(ctx, m)
+				})
+			},
+			ent.OpDeleteOne|ent.OpDelete,
+		),
+	}
+}
+
+// P adds the "not deleted" predicate to a query or mutation
+func (d SoftDeleteMixin) P(w interface{ WhereP(...func(*sql.Selector)) }) {
+	w.WhereP(sql.FieldIsNull(d.Fields()[0].Descriptor().Name))
+}
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Tag is a free-form label on items, e.g. "sale"
+type Tag struct {
+	ent.Schema
+}
+
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.String("name").
+			NotEmpty().
+			Unique(),
+	}
+}
+
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("items", Item.Type),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -20,5 +21,17 @@ func (User) Fields() []ent.Field {
 		field.String("email"). // New field
 					Optional().
 					Unique(),
+		// role controls which routes the user may call.
+		// Self-registered users are viewers until an admin grants them more.
+		field.Enum("role").
+			Values("admin", "editor", "viewer").
+			Default("viewer"),
+	}
+}
+
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("items", Item.Type),
+		edge.To("refresh_tokens", RefreshToken.Type),
 	}
 }
@@ -12,8 +12,24 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
+	// Category is the client for interacting with the Category builders.
+	Category *CategoryClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// ItemImage is the client for interacting with the ItemImage builders.
+	ItemImage *ItemImageClient
+	// ItemRevision is the client for interacting with the ItemRevision builders.
+	ItemRevision *ItemRevisionClient
+	// PriceChange is the client for interacting with the PriceChange builders.
+	PriceChange *PriceChangeClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// RevokedToken is the client for interacting with the RevokedToken builders.
+	RevokedToken *RevokedTokenClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -147,7 +163,15 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditEntry = NewAuditEntryClient(tx.config)
+	tx.Category = NewCategoryClient(tx.config)
 	tx.Item = NewItemClient(tx.config)
+	tx.ItemImage = NewItemImageClient(tx.config)
+	tx.ItemRevision = NewItemRevisionClient(tx.config)
+	tx.PriceChange = NewPriceChangeClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.RevokedToken = NewRevokedTokenClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -158,7 +182,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Item.QueryXXX(), the query will be executed
+// applies a query, for example: AuditEntry.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"gin-crud/ent"
+	"gin-crud/internal/config"
+	"gin-crud/internal/keys"
+	"gin-crud/internal/models"
+	"gin-crud/internal/money"
+	"gin-crud/internal/storage"
+)
+
+// App holds the dependencies shared by the HTTP handlers and background jobs.
+// Nothing in it is global, so tests can build one around an enttest client
+// and several apps can run in the same process.
+type App struct {
+	Config  *config.Config
+	Client  *ent.Client
+	Logger  *log.Logger
+	Keys    *keys.KeySet
+	Storage storage.Storage
+	Rates   *money.Rates
+}
+
+// New builds an App from cfg: it loads the JWT keys, storage backend and
+// exchange rates, then connects to the database. It refuses to start when
+// migrations are pending unless DB_AUTO_MIGRATE applies them.
+func New(ctx context.Context, cfg *config.Config, logger *log.Logger) (*App, error) {
+	keySet, err := keys.Load(cfg.JWT, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load JWT keys: %w", err)
+	}
+	store, err := storage.New(cfg.Storage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure storage: %w", err)
+	}
+	rates, err := money.LoadRates(cfg.Money.ExchangeRatesFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load exchange rates: %w", err)
+	}
+	db, err := models.Open(cfg.DB)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.DB.AutoMigrate {
+		applied, err := db.Migrate(ctx)
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+		for _, m := range applied {
+			logger.Printf("Applied migration %s_%s", m.Version, m.Name)
+		}
+	} else if err := db.CheckSchema(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &App{
+		Config:  cfg,
+		Client:  db.Client,
+		Logger:  logger,
+		Keys:    keySet,
+		Storage: store,
+		Rates:   rates,
+	}, nil
+}
+
+// Close releases the database connection
+func (a *App) Close() error {
+	return a.Client.Close()
+}
@@ -0,0 +1,255 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gin-crud/ent"
+	"gin-crud/ent/auditentry"
+	"gin-crud/ent/hook"
+	"gin-crud/ent/item"
+	"gin-crud/ent/schema"
+	"gin-crud/ent/user"
+	"gin-crud/internal/txhook"
+)
+
+// redactedFields are recorded as changed without their values
+var redactedFields = map[string]bool{
+	user.FieldPassword: true,
+}
+
+// ignoredFields are bookkeeping columns that are not worth recording
+var ignoredFields = map[string]bool{
+	item.FieldVersion: true,
+	"edges":           true,
+}
+
+type auditingKey struct{}
+
+// Register installs the audit hooks for items and users on client
+func Register(client *ent.Client) {
+	client.Item.Use(itemHook)
+	client.User.Use(userHook)
+}
+
+// actorID returns the authenticated user stored in ctx by JWTMiddleware
+func actorID(ctx context.Context) *int {
+	if id, ok := ctx.Value("userID").(int); ok {
+		return &id
+	}
+	return nil
+}
+
+// nested reports whether the mutation is re-dispatched from within another
+// audited mutation, such as a delete turned into a soft delete
+func nested(ctx context.Context) bool {
+	v, _ := ctx.Value(auditingKey{}).(bool)
+	return v
+}
+
+func itemHook(next ent.Mutator) ent.Mutator {
+	return hook.ItemFunc(func(ctx context.Context, m *ent.ItemMutation) (ent.Value, error) {
+		if nested(ctx) {
+			return next.Mutate(ctx, m)
+		}
+
+		// Soft-deleted rows must be visible to read the state around the change
+		load := func(ids []int64) (map[int64]any, error) {
+			rows, err := txhook.Client(ctx, m).Item.Query().Where(item.IDIn(ids...)).All(schema.SkipSoftDelete(ctx))
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[int64]any, len(rows))
+			for _, row := range rows {
+				byID[row.ID] = row
+			}
+			return byID, nil
+		}
+
+		var ids []int64
+		var before map[int64]any
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = m.IDs(ctx); err != nil {
+				return nil, err
+			}
+			if before, err = load(ids); err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := next.Mutate(context.WithValue(ctx, auditingKey{}, true), m)
+		if err != nil {
+			return v, err
+		}
+
+		var after map[int64]any
+		if created, ok := v.(*ent.Item); ok {
+			ids = []int64{created.ID}
+			after = map[int64]any{created.ID: created}
+		} else if after, err = load(ids); err != nil {
+			return nil, err
+		}
+
+		return v, record(ctx, txhook.Client(ctx, m), item.Label, m.Op(), ids, before, after)
+	})
+}
+
+func userHook(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+		if nested(ctx) {
+			return next.Mutate(ctx, m)
+		}
+
+		load := func(ids []int) (map[int64]any, error) {
+			rows, err := txhook.Client(ctx, m).User.Query().Where(user.IDIn(ids...)).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[int64]any, len(rows))
+			for _, row := range rows {
+				byID[int64(row.ID)] = row
+			}
+			return byID, nil
+		}
+
+		var ids []int
+		var before map[int64]any
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = m.IDs(ctx); err != nil {
+				return nil, err
+			}
+			if before, err = load(ids); err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := next.Mutate(context.WithValue(ctx, auditingKey{}, true), m)
+		if err != nil {
+			return v, err
+		}
+
+		var after map[int64]any
+		if created, ok := v.(*ent.User); ok {
+			ids = []int{created.ID}
+			after = map[int64]any{int64(created.ID): created}
+		} else if after, err = load(ids); err != nil {
+			return nil, err
+		}
+
+		ids64 := make([]int64, len(ids))
+		for i, id := range ids {
+			ids64[i] = int64(id)
+		}
+		return v, record(ctx, txhook.Client(ctx, m), user.Label, m.Op(), ids64, before, after)
+	})
+}
+
+// record writes one audit entry per affected entity
+func record(ctx context.Context, client *ent.Client, entity string, op ent.Op, ids []int64, before, after map[int64]any) error {
+	operation := auditentry.OperationUpdate
+	switch {
+	case op.Is(ent.OpCreate):
+		operation = auditentry.OperationCreate
+	case op.Is(ent.OpDelete | ent.OpDeleteOne):
+		operation = auditentry.OperationDelete
+	}
+
+	builders := make([]*ent.AuditEntryCreate, 0, len(ids))
+	for _, id := range ids {
+		oldValues, newValues, err := diff(before[id], after[id])
+		if err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+		if len(oldValues) == 0 && len(newValues) == 0 {
+			continue
+		}
+
+		builders = append(builders, client.AuditEntry.
+			Create().
+			SetNillableActorID(actorID(ctx)).
+			SetEntityType(entity).
+			SetEntityID(id).
+			SetOperation(operation).
+			SetBefore(oldValues).
+			SetAfter(newValues))
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+	return client.AuditEntry.CreateBulk(builders...).Exec(ctx)
+}
+
+// diff returns the fields that differ between two entities, as they appear in
+// their JSON form. A nil entity counts as having no fields.
+func diff(before, after any) (map[string]any, map[string]any, error) {
+	oldFields, err := fields(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	newFields, err := fields(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Fields missing from either side are zero values dropped by omitempty,
+	// so they are recorded as null
+	oldValues, newValues := map[string]any{}, map[string]any{}
+	for _, name := range union(oldFields, newFields) {
+		if reflect.DeepEqual(oldFields[name], newFields[name]) {
+			continue
+		}
+		if before != nil {
+			oldValues[name] = oldFields[name]
+		}
+		if after != nil {
+			newValues[name] = newFields[name]
+		}
+	}
+
+	for name := range redactedFields {
+		if _, ok := oldValues[name]; ok {
+			oldValues[name] = "[redacted]"
+		}
+		if _, ok := newValues[name]; ok {
+			newValues[name] = "[redacted]"
+		}
+	}
+	return oldValues, newValues, nil
+}
+
+// fields flattens an entity into its JSON fields
+func fields(entity any) (map[string]any, error) {
+	if entity == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	for name := range ignoredFields {
+		delete(out, name)
+	}
+	return out, nil
+}
+
+// union returns the keys present in either map
+func union(a, b map[string]any) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
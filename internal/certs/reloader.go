@@ -0,0 +1,141 @@
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"gin-crud/internal/config"
+)
+
+// minVersions maps the accepted TLS_MIN_VERSION values to TLS versions
+var minVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// clientAuthTypes maps TLS_CLIENT_AUTH values to how client certificates are checked
+var clientAuthTypes = map[string]tls.ClientAuthType{
+	"none":     tls.NoClientCert,
+	"optional": tls.VerifyClientCertIfGiven,
+	"require":  tls.RequireAndVerifyClientCert,
+}
+
+// Reloader serves the certificate and client CA bundle from disk and
+// reloads them when their files change, so renewed certificates are picked
+// up without a restart
+type Reloader struct {
+	cfg     config.TLSConfig
+	logger  *log.Logger
+	current atomic.Pointer[tls.Config]
+	// modTimes are the modification times of the files last loaded successfully
+	modTimes map[string]time.Time
+}
+
+// NewReloader loads the files named in cfg and fails if any of them is unusable
+func NewReloader(cfg config.TLSConfig, logger *log.Logger) (*Reloader, error) {
+	r := &Reloader{cfg: cfg, logger: logger}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// TLSConfig returns the configuration to serve with. Every handshake uses
+// the most recently loaded certificate and CA bundle.
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: minVersions[r.cfg.MinVersion],
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.current.Load(), nil
+		},
+	}
+}
+
+// Watch checks the files every ReloadInterval until ctx is cancelled and
+// reloads them when one changed. A failed reload is logged and the previous
+// certificate stays in use; it is retried on the next check.
+func (r *Reloader) Watch(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !r.changed() {
+			continue
+		}
+		if err := r.load(); err != nil {
+			r.logger.Printf("Error reloading TLS certificate: %v", err)
+			continue
+		}
+		r.logger.Println("Reloaded TLS certificate")
+	}
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.cfg.CertFile, r.cfg.KeyFile}
+	if r.cfg.ClientCAFile != "" {
+		files = append(files, r.cfg.ClientCAFile)
+	}
+	return files
+}
+
+// changed reports whether a file was modified since it was last loaded
+func (r *Reloader) changed() bool {
+	for _, path := range r.files() {
+		info, err := os.Stat(path)
+		if err != nil || !info.ModTime().Equal(r.modTimes[path]) {
+			return true
+		}
+	}
+	return false
+}
+
+// load reads every file and swaps in a new configuration
+func (r *Reloader) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, path := range r.files() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		modTimes[path] = info.ModTime()
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate: %w", err)
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   minVersions[r.cfg.MinVersion],
+		ClientAuth:   clientAuthTypes[r.cfg.ClientAuth],
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+	if r.cfg.ClientCAFile != "" {
+		bundle, err := os.ReadFile(r.cfg.ClientCAFile)
+		if err != nil {
+			return err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bundle) {
+			return fmt.Errorf("no certificates found in %s", r.cfg.ClientCAFile)
+		}
+		cfg.ClientCAs = pool
+	}
+
+	r.current.Store(cfg)
+	r.modTimes = modTimes
+	return nil
+}
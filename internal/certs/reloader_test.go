@@ -0,0 +1,239 @@
+package certs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gin-crud/internal/config"
+)
+
+// testFiles names a certificate and key pair in a temporary directory
+type testFiles struct {
+	cert, key string
+	// modTime is bumped on every write so changes are seen regardless of the
+	// file system's timestamp resolution
+	modTime time.Time
+}
+
+func newTestFiles(t *testing.T) *testFiles {
+	dir := t.TempDir()
+	return &testFiles{
+		cert:    filepath.Join(dir, "cert.pem"),
+		key:     filepath.Join(dir, "key.pem"),
+		modTime: time.Now().Add(-time.Hour),
+	}
+}
+
+// write generates a self-signed certificate for commonName and writes it
+// with its key
+func (f *testFiles) write(t *testing.T, commonName string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.writeFile(t, f.cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	f.writeFile(t, f.key, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func (f *testFiles) writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	f.modTime = f.modTime.Add(time.Second)
+	if err := os.Chtimes(path, f.modTime, f.modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (f *testFiles) config() config.TLSConfig {
+	return config.TLSConfig{
+		CertFile:       f.cert,
+		KeyFile:        f.key,
+		MinVersion:     "1.2",
+		ClientAuth:     "none",
+		ReloadInterval: 10 * time.Millisecond,
+	}
+}
+
+func newTestReloader(t *testing.T, cfg config.TLSConfig) *Reloader {
+	t.Helper()
+	r, err := NewReloader(cfg, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewReloader: %v", err)
+	}
+	return r
+}
+
+// servedName returns the common name of the certificate r currently serves
+func servedName(t *testing.T, r *Reloader) string {
+	t.Helper()
+	cfg, err := r.TLSConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(cfg.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert.Subject.CommonName
+}
+
+func TestReloaderReloadsChangedFiles(t *testing.T) {
+	files := newTestFiles(t)
+	files.write(t, "first")
+	r := newTestReloader(t, files.config())
+
+	if got := servedName(t, r); got != "first" {
+		t.Fatalf("serving %q, want first", got)
+	}
+	if r.changed() {
+		t.Fatal("changed right after loading")
+	}
+
+	files.write(t, "second")
+	if !r.changed() {
+		t.Fatal("rewritten files not reported as changed")
+	}
+	if got := servedName(t, r); got != "first" {
+		t.Fatalf("serving %q before the reload, want first", got)
+	}
+	if err := r.load(); err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if got := servedName(t, r); got != "second" {
+		t.Errorf("serving %q after the reload, want second", got)
+	}
+	if r.changed() {
+		t.Error("changed after reloading")
+	}
+}
+
+func TestReloaderKeepsConfigOnBadFiles(t *testing.T) {
+	tests := []struct {
+		name  string
+		spoil func(t *testing.T, files *testFiles)
+	}{
+		{"corrupt certificate", func(t *testing.T, files *testFiles) {
+			files.writeFile(t, files.cert, []byte("not a certificate"))
+		}},
+		{"key of another certificate", func(t *testing.T, files *testFiles) {
+			key, _ := os.ReadFile(files.key)
+			files.write(t, "other")
+			files.writeFile(t, files.key, key)
+		}},
+		{"missing key", func(t *testing.T, files *testFiles) {
+			os.Remove(files.key)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := newTestFiles(t)
+			files.write(t, "good")
+			r := newTestReloader(t, files.config())
+			before := r.current.Load()
+
+			tt.spoil(t, files)
+			if !r.changed() {
+				t.Fatal("bad files not reported as changed")
+			}
+			if err := r.load(); err == nil {
+				t.Fatal("load accepted bad files")
+			}
+			if r.current.Load() != before {
+				t.Error("a failed load replaced the configuration")
+			}
+			if !r.changed() {
+				t.Error("a failed load is not retried on the next check")
+			}
+
+			files.write(t, "fixed")
+			if err := r.load(); err != nil {
+				t.Fatalf("load after fixing the files: %v", err)
+			}
+			if got := servedName(t, r); got != "fixed" {
+				t.Errorf("serving %q, want fixed", got)
+			}
+		})
+	}
+}
+
+func TestNewReloaderRejectsBadFiles(t *testing.T) {
+	files := newTestFiles(t)
+	files.write(t, "server")
+	ca := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(ca, []byte("no certificates here"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := files.config()
+	cfg.ClientAuth = "require"
+	cfg.ClientCAFile = ca
+	if _, err := NewReloader(cfg, log.New(io.Discard, "", 0)); err == nil {
+		t.Error("NewReloader accepted a CA bundle without certificates")
+	}
+
+	cfg.ClientCAFile = files.cert
+	r := newTestReloader(t, cfg)
+	current := r.current.Load()
+	if current.ClientAuth != tls.RequireAndVerifyClientCert || current.ClientCAs == nil {
+		t.Errorf("client auth %v with CAs %v, want required client certificates", current.ClientAuth, current.ClientCAs)
+	}
+}
+
+func TestReloaderWatch(t *testing.T) {
+	files := newTestFiles(t)
+	files.write(t, "first")
+	r := newTestReloader(t, files.config())
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		r.Watch(ctx)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	files.write(t, "second")
+	deadline := time.Now().Add(5 * time.Second)
+	for servedName(t, r) != "second" {
+		if time.Now().After(deadline) {
+			t.Fatal("Watch did not pick up the new certificate")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
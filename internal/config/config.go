@@ -1,25 +1,229 @@
 package config
 
 import (
-	"log"
-	"os"
-
-	"github.com/joho/godotenv"
+	"fmt"
+	"strings"
+	"time"
 )
 
-// LoadEnv loads environment variables from the .env file
-func LoadEnv() {
-	err := godotenv.Load()
-	if err != nil {
-		log.Fatalf("Failed to load .env file: %v", err)
+// Config holds the settings the application is built from. It is loaded
+// once at startup by Load and handed to the packages that need it.
+//
+// Every setting has a key used in config files, e.g. db.host, an
+// environment variable, e.g. DB_HOST, and a command-line flag named after
+// the key, e.g. -db-host.
+type Config struct {
+	Server  ServerConfig  `config:"server"`
+	TLS     TLSConfig     `config:"tls"`
+	DB      DBConfig      `config:"db"`
+	JWT     JWTConfig     `config:"jwt"`
+	Storage StorageConfig `config:"storage"`
+	Money   MoneyConfig   `config:"money"`
+	Jobs    JobsConfig    `config:"jobs"`
+}
+
+// ServerConfig configures the HTTP server
+type ServerConfig struct {
+	Addr            string        `config:"addr" env:"SERVER_ADDR" help:"address the HTTP server listens on"`
+	ReadTimeout     time.Duration `config:"read_timeout" env:"SERVER_READ_TIMEOUT" help:"maximum time to read a request, including the body"`
+	WriteTimeout    time.Duration `config:"write_timeout" env:"SERVER_WRITE_TIMEOUT" help:"maximum time to write a response"`
+	IdleTimeout     time.Duration `config:"idle_timeout" env:"SERVER_IDLE_TIMEOUT" help:"how long idle keep-alive connections stay open"`
+	MaxHeaderBytes  int           `config:"max_header_bytes" env:"SERVER_MAX_HEADER_BYTES" help:"maximum size of request headers"`
+	ShutdownTimeout time.Duration `config:"shutdown_timeout" env:"SERVER_SHUTDOWN_TIMEOUT" help:"how long in-flight requests may finish on shutdown"`
+}
+
+// TLSConfig enables HTTPS and, optionally, client certificate authentication
+type TLSConfig struct {
+	CertFile       string        `config:"cert_file" env:"TLS_CERT_FILE" help:"PEM certificate chain; serves HTTPS together with TLS_KEY_FILE"`
+	KeyFile        string        `config:"key_file" env:"TLS_KEY_FILE" help:"PEM private key"`
+	MinVersion     string        `config:"min_version" env:"TLS_MIN_VERSION" help:"lowest accepted TLS version, 1.2 or 1.3"`
+	ClientAuth     string        `config:"client_auth" env:"TLS_CLIENT_AUTH" help:"client certificates: none, optional or require"`
+	ClientCAFile   string        `config:"client_ca_file" env:"TLS_CLIENT_CA_FILE" help:"PEM CA bundle client certificates are verified against"`
+	ReloadInterval time.Duration `config:"reload_interval" env:"TLS_RELOAD_INTERVAL" help:"how often the certificate files are checked for changes"`
+}
+
+// Enabled reports whether the server should serve HTTPS
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != ""
+}
+
+// DBConfig holds the PostgreSQL connection settings
+type DBConfig struct {
+	Host        string `config:"host" env:"DB_HOST" help:"database host"`
+	Port        int    `config:"port" env:"DB_PORT" help:"database port"`
+	User        string `config:"user" env:"DB_USER" help:"database user"`
+	Password    string `config:"password" env:"DB_PASSWORD" help:"database password"`
+	Name        string `config:"name" env:"DB_NAME" help:"database name"`
+	SSLMode     string `config:"sslmode" env:"DB_SSLMODE" help:"lib/pq sslmode: disable, allow, prefer, require, verify-ca or verify-full"`
+	AutoMigrate bool   `config:"auto_migrate" env:"DB_AUTO_MIGRATE" help:"apply pending migrations when the server starts instead of refusing to start"`
+}
+
+// DSN returns the connection string for lib/pq
+func (c DBConfig) DSN() string {
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		c.Host, c.Port, c.User, c.Password, c.Name, c.SSLMode)
+}
+
+// JWTConfig locates the keys used to sign and verify tokens
+type JWTConfig struct {
+	KeysDir     string        `config:"keys_dir" env:"JWT_KEYS_DIR" help:"directory of <kid>.pem signing keys; empty uses an ephemeral key"`
+	ActiveKID   string        `config:"active_kid" env:"JWT_ACTIVE_KID" help:"kid used to sign new tokens"`
+	RetiredKeys string        `config:"retired_keys" env:"JWT_RETIRED_KEYS" help:"comma separated kid=RFC3339 retirement times"`
+	KeyGrace    time.Duration `config:"key_grace" env:"JWT_KEY_GRACE" help:"how long retired keys are still accepted"`
+}
+
+// StorageConfig selects where uploaded images are stored
+type StorageConfig struct {
+	Driver    string   `config:"driver" env:"STORAGE_DRIVER" help:"local or s3"`
+	LocalDir  string   `config:"local_dir" env:"STORAGE_LOCAL_DIR" help:"directory for the local driver"`
+	PublicURL string   `config:"public_url" env:"STORAGE_PUBLIC_URL" help:"base URL of stored files (default /media for local)"`
+	S3        S3Config `config:"s3"`
+}
+
+// S3Config configures the s3 storage driver
+type S3Config struct {
+	Endpoint        string `config:"endpoint" env:"S3_ENDPOINT" help:"e.g. https://s3.eu-west-1.amazonaws.com or http://localhost:9000"`
+	Region          string `config:"region" env:"S3_REGION" help:"signing region"`
+	Bucket          string `config:"bucket" env:"S3_BUCKET" help:"bucket name"`
+	AccessKeyID     string `config:"access_key_id" env:"S3_ACCESS_KEY_ID" help:"access key"`
+	SecretAccessKey string `config:"secret_access_key" env:"S3_SECRET_ACCESS_KEY" help:"secret key"`
+}
+
+// MoneyConfig configures price conversion
+type MoneyConfig struct {
+	ExchangeRatesFile string `config:"exchange_rates_file" env:"EXCHANGE_RATES_FILE" help:"JSON exchange rate table for ?currency= conversion"`
+}
+
+// JobsConfig configures the background jobs
+type JobsConfig struct {
+	ItemRetention          time.Duration `config:"item_retention" env:"ITEM_RETENTION" help:"how long soft-deleted items can still be restored"`
+	PurgeInterval          time.Duration `config:"purge_interval" env:"PURGE_INTERVAL" help:"how often deleted items are purged"`
+	PriceSchedulerInterval time.Duration `config:"price_scheduler_interval" env:"PRICE_SCHEDULER_INTERVAL" help:"how often scheduled price changes are checked"`
+}
+
+// Default returns the configuration used for settings no source sets
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Addr:            ":8080",
+			ReadTimeout:     30 * time.Second,
+			WriteTimeout:    60 * time.Second,
+			IdleTimeout:     2 * time.Minute,
+			MaxHeaderBytes:  1 << 20,
+			ShutdownTimeout: 30 * time.Second,
+		},
+		TLS: TLSConfig{
+			MinVersion:     "1.2",
+			ClientAuth:     "none",
+			ReloadInterval: 30 * time.Second,
+		},
+		DB:  DBConfig{Port: 5432},
+		JWT: JWTConfig{KeyGrace: 24 * time.Hour},
+		Storage: StorageConfig{
+			Driver:   "local",
+			LocalDir: "uploads",
+			S3:       S3Config{Region: "us-east-1"},
+		},
+		Jobs: JobsConfig{
+			ItemRetention:          30 * 24 * time.Hour,
+			PurgeInterval:          time.Hour,
+			PriceSchedulerInterval: time.Minute,
+		},
 	}
 }
 
-// GetEnv retrieves an environment variable or panics if not found
-func GetEnv(key string) string {
-	value := os.Getenv(key)
-	if value == "" {
-		log.Fatalf("Environment variable %s is missing", key)
+// Errors lists every problem found in a configuration
+type Errors []string
+
+func (e Errors) Error() string {
+	return "invalid configuration:\n  " + strings.Join(e, "\n  ")
+}
+
+// Validate checks the configuration as a whole and reports every problem at once
+func (c *Config) Validate() error {
+	var errs Errors
+	required := func(value, env string) {
+		if value == "" {
+			errs = append(errs, env+" is required")
+		}
+	}
+	positive := func(d time.Duration, env string) {
+		if d <= 0 {
+			errs = append(errs, env+" must be positive")
+		}
+	}
+
+	required(c.Server.Addr, "SERVER_ADDR")
+	positive(c.Server.ReadTimeout, "SERVER_READ_TIMEOUT")
+	positive(c.Server.WriteTimeout, "SERVER_WRITE_TIMEOUT")
+	positive(c.Server.IdleTimeout, "SERVER_IDLE_TIMEOUT")
+	positive(c.Server.ShutdownTimeout, "SERVER_SHUTDOWN_TIMEOUT")
+	if c.Server.MaxHeaderBytes <= 0 {
+		errs = append(errs, "SERVER_MAX_HEADER_BYTES must be positive")
+	}
+
+	if c.TLS.Enabled() {
+		required(c.TLS.CertFile, "TLS_CERT_FILE")
+		required(c.TLS.KeyFile, "TLS_KEY_FILE")
+		positive(c.TLS.ReloadInterval, "TLS_RELOAD_INTERVAL")
+	}
+	switch c.TLS.MinVersion {
+	case "1.2", "1.3":
+	default:
+		errs = append(errs, fmt.Sprintf("TLS_MIN_VERSION %q must be 1.2 or 1.3", c.TLS.MinVersion))
+	}
+	switch c.TLS.ClientAuth {
+	case "none":
+		if c.TLS.ClientCAFile != "" {
+			errs = append(errs, "TLS_CLIENT_CA_FILE is set but TLS_CLIENT_AUTH is none")
+		}
+	case "optional", "require":
+		if !c.TLS.Enabled() {
+			errs = append(errs, "TLS_CLIENT_AUTH "+c.TLS.ClientAuth+" requires TLS_CERT_FILE and TLS_KEY_FILE")
+		}
+		required(c.TLS.ClientCAFile, "TLS_CLIENT_CA_FILE")
+	default:
+		errs = append(errs, fmt.Sprintf("TLS_CLIENT_AUTH %q must be none, optional or require", c.TLS.ClientAuth))
+	}
+
+	required(c.DB.Host, "DB_HOST")
+	required(c.DB.User, "DB_USER")
+	required(c.DB.Name, "DB_NAME")
+	if c.DB.Port <= 0 || c.DB.Port > 65535 {
+		errs = append(errs, fmt.Sprintf("DB_PORT %d is not a valid port", c.DB.Port))
+	}
+	required(c.DB.SSLMode, "DB_SSLMODE")
+	switch c.DB.SSLMode {
+	case "", "disable", "allow", "prefer", "require", "verify-ca", "verify-full":
+	default:
+		errs = append(errs, fmt.Sprintf("DB_SSLMODE %q is not a valid sslmode", c.DB.SSLMode))
+	}
+
+	if c.JWT.KeysDir != "" {
+		required(c.JWT.ActiveKID, "JWT_ACTIVE_KID")
+	}
+	if c.JWT.KeyGrace < 0 {
+		errs = append(errs, "JWT_KEY_GRACE must not be negative")
+	}
+
+	switch c.Storage.Driver {
+	case "local":
+		required(c.Storage.LocalDir, "STORAGE_LOCAL_DIR")
+	case "s3":
+		required(c.Storage.S3.Endpoint, "S3_ENDPOINT")
+		required(c.Storage.S3.Bucket, "S3_BUCKET")
+		required(c.Storage.S3.AccessKeyID, "S3_ACCESS_KEY_ID")
+		required(c.Storage.S3.SecretAccessKey, "S3_SECRET_ACCESS_KEY")
+	default:
+		errs = append(errs, fmt.Sprintf("STORAGE_DRIVER %q must be local or s3", c.Storage.Driver))
+	}
+
+	positive(c.Jobs.ItemRetention, "ITEM_RETENTION")
+	positive(c.Jobs.PurgeInterval, "PURGE_INTERVAL")
+	positive(c.Jobs.PriceSchedulerInterval, "PRICE_SCHEDULER_INTERVAL")
+
+	if len(errs) > 0 {
+		return errs
 	}
-	return value
+	return nil
 }
@@ -0,0 +1,240 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// setting is a single configurable field of Config
+type setting struct {
+	// key names the setting in config files, e.g. db.host
+	key   string
+	env   string
+	help  string
+	field reflect.Value
+}
+
+// flagName turns a key such as jwt.keys_dir into jwt-keys-dir
+func (s setting) flagName() string {
+	return strings.NewReplacer(".", "-", "_", "-").Replace(s.key)
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// set parses value into the setting's field
+func (s setting) set(value string) error {
+	switch {
+	case s.field.Type() == durationType:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", value)
+		}
+		s.field.SetInt(int64(d))
+	case s.field.Kind() == reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", value)
+		}
+		s.field.SetInt(int64(n))
+	case s.field.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", value)
+		}
+		s.field.SetBool(b)
+	default:
+		s.field.SetString(value)
+	}
+	return nil
+}
+
+// settingsOf lists the settings of cfg, in declaration order
+func settingsOf(cfg *Config) []setting {
+	var out []setting
+	var walk func(v reflect.Value, prefix string)
+	walk = func(v reflect.Value, prefix string) {
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			key := prefix + f.Tag.Get("config")
+			if f.Type.Kind() == reflect.Struct {
+				walk(v.Field(i), key+".")
+				continue
+			}
+			out = append(out, setting{key: key, env: f.Tag.Get("env"), help: f.Tag.Get("help"), field: v.Field(i)})
+		}
+	}
+	walk(reflect.ValueOf(cfg).Elem(), "")
+	return out
+}
+
+// Loader builds a Config from defaults, a config file, the environment and
+// command-line flags
+type Loader struct {
+	cfg      *Config
+	settings []setting
+	file     *string
+	flags    *flag.FlagSet
+}
+
+// NewLoader registers -config and a flag for every setting on flags.
+// Call Load once flags has been parsed.
+func NewLoader(flags *flag.FlagSet) *Loader {
+	l := &Loader{cfg: Default(), flags: flags}
+	l.settings = settingsOf(l.cfg)
+	l.file = flags.String("config", "", "YAML or TOML config file (env CONFIG_FILE)")
+	for _, s := range l.settings {
+		usage := fmt.Sprintf("%s (env %s)", s.help, s.env)
+		def := ""
+		if !s.field.IsZero() {
+			def = fmt.Sprint(s.field.Interface())
+		}
+		flags.String(s.flagName(), def, usage)
+	}
+	return l
+}
+
+// Load applies, in increasing order of precedence:
+//
+//  1. built-in defaults
+//  2. the YAML or TOML file named by -config or CONFIG_FILE
+//  3. environment variables, including those from a .env file in the working directory
+//  4. command-line flags
+//
+// A .env file is optional and never overrides variables that are already
+// set. Problems from every source are reported together with the
+// validation errors.
+func (l *Loader) Load() (*Config, error) {
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to load .env file: %w", err)
+	}
+
+	var errs Errors
+	byKey := make(map[string]setting, len(l.settings))
+	for _, s := range l.settings {
+		byKey[s.key] = s
+	}
+
+	path := *l.file
+	if path == "" {
+		path = os.Getenv("CONFIG_FILE")
+	}
+	if path != "" {
+		values, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			s, ok := byKey[key]
+			if !ok {
+				errs = append(errs, fmt.Sprintf("%s: unknown setting %s", path, key))
+				continue
+			}
+			if err := s.set(values[key]); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %s: %v", path, key, err))
+			}
+		}
+	}
+
+	for _, s := range l.settings {
+		if value := os.Getenv(s.env); value != "" {
+			if err := s.set(value); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", s.env, err))
+			}
+		}
+	}
+
+	byFlag := make(map[string]setting, len(l.settings))
+	for _, s := range l.settings {
+		byFlag[s.flagName()] = s
+	}
+	l.flags.Visit(func(f *flag.Flag) {
+		if s, ok := byFlag[f.Name]; ok {
+			if err := s.set(f.Value.String()); err != nil {
+				errs = append(errs, fmt.Sprintf("-%s: %v", f.Name, err))
+			}
+		}
+	})
+
+	if err := l.cfg.Validate(); err != nil {
+		errs = append(errs, err.(Errors)...)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return l.cfg, nil
+}
+
+// Load parses args as command-line flags and loads the configuration
+func Load(args []string) (*Config, error) {
+	flags := flag.NewFlagSet("gin-crud", flag.ContinueOnError)
+	loader := NewLoader(flags)
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	return loader.Load()
+}
+
+// readFile reads a YAML or TOML config file, chosen by extension, into
+// values keyed like db.host
+func readFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var raw map[string]any
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &raw)
+	case ".toml":
+		err = toml.Unmarshal(data, &raw)
+	default:
+		return nil, fmt.Errorf("%s: unsupported config file type %q, use .yaml, .yml or .toml", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	if err := flatten("", raw, values); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return values, nil
+}
+
+// flatten turns nested sections into dotted keys
+func flatten(prefix string, m map[string]any, out map[string]string) error {
+	for k, v := range m {
+		switch v := v.(type) {
+		case nil:
+		case map[string]any:
+			if err := flatten(prefix+k+".", v, out); err != nil {
+				return err
+			}
+		case []any:
+			return fmt.Errorf("%s%s: lists are not supported", prefix, k)
+		default:
+			out[prefix+k] = fmt.Sprint(v)
+		}
+	}
+	return nil
+}
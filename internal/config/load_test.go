@@ -0,0 +1,230 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+// isolate runs the test in an empty directory, so no .env file is picked up,
+// with every configuration variable unset
+func isolate(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	envs := []string{"CONFIG_FILE"}
+	for _, s := range settingsOf(Default()) {
+		envs = append(envs, s.env)
+	}
+	for _, env := range envs {
+		// Setenv restores the variable afterwards, including values .env sets
+		t.Setenv(env, "")
+		os.Unsetenv(env)
+	}
+	return dir
+}
+
+func writeFile(t *testing.T, path, data string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// loadErrors returns the configuration problems Load reported
+func loadErrors(t *testing.T, args ...string) Errors {
+	t.Helper()
+	_, err := Load(args)
+	var errs Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Load(%q) = %v, want configuration errors", args, err)
+	}
+	return errs
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	dir := isolate(t)
+	writeFile(t, filepath.Join(dir, "config.yaml"), `
+server:
+  read_timeout: 5s
+db:
+  host: file-host
+  user: file-user
+  name: file-name
+  password: file-password
+  sslmode: disable
+`)
+	writeFile(t, filepath.Join(dir, ".env"), "DB_NAME=dotenv-name\nDB_PASSWORD=dotenv-password\nDB_PORT=6432\n")
+	t.Setenv("DB_USER", "env-user")
+	t.Setenv("DB_NAME", "env-name")
+
+	cfg, err := Load([]string{"-config", "config.yaml", "-db-name", "flag-name"})
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	tests := []struct {
+		setting   string
+		got, want any
+	}{
+		{"default", cfg.Server.Addr, ":8080"},
+		{"file over default", cfg.Server.ReadTimeout, 5 * time.Second},
+		{"file", cfg.DB.Host, "file-host"},
+		{"env over file", cfg.DB.User, "env-user"},
+		{".env over file", cfg.DB.Password, "dotenv-password"},
+		{".env over default", cfg.DB.Port, 6432},
+		{"flag over env and .env", cfg.DB.Name, "flag-name"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.setting, tt.got, tt.want)
+		}
+	}
+}
+
+func TestLoadDotenvDoesNotOverrideEnv(t *testing.T) {
+	dir := isolate(t)
+	writeFile(t, filepath.Join(dir, ".env"), "DB_HOST=dotenv-host\nDB_USER=dotenv-user\nDB_NAME=db\nDB_SSLMODE=disable\n")
+	t.Setenv("DB_HOST", "env-host")
+
+	cfg, err := Load(nil)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.DB.Host != "env-host" {
+		t.Errorf("DB.Host = %q, want the environment to win over .env", cfg.DB.Host)
+	}
+	if cfg.DB.User != "dotenv-user" {
+		t.Errorf("DB.User = %q, want it read from .env", cfg.DB.User)
+	}
+}
+
+func TestLoadTOMLFromConfigFileEnv(t *testing.T) {
+	dir := isolate(t)
+	path := filepath.Join(dir, "config.toml")
+	writeFile(t, path, `
+[db]
+host = "toml-host"
+user = "toml-user"
+name = "toml-name"
+port = 5433
+sslmode = "disable"
+
+[jobs]
+purge_interval = "10m"
+`)
+	t.Setenv("CONFIG_FILE", path)
+
+	cfg, err := Load(nil)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.DB.Host != "toml-host" || cfg.DB.Port != 5433 || cfg.Jobs.PurgeInterval != 10*time.Minute {
+		t.Errorf("got host %q, port %d, purge interval %v from the TOML file", cfg.DB.Host, cfg.DB.Port, cfg.Jobs.PurgeInterval)
+	}
+}
+
+func TestLoadReportsEveryProblem(t *testing.T) {
+	dir := isolate(t)
+	writeFile(t, filepath.Join(dir, "config.yaml"), `
+db:
+  user: app
+  colour: blue
+server:
+  idle_timeout: soon
+`)
+	t.Setenv("DB_PORT", "not-a-port")
+	t.Setenv("STORAGE_DRIVER", "ftp")
+
+	errs := loadErrors(t, "-config", "config.yaml", "-jwt-key-grace", "-1h")
+	for _, want := range []string{
+		"config.yaml: unknown setting db.colour",
+		`config.yaml: server.idle_timeout: invalid duration "soon"`,
+		`DB_PORT: invalid integer "not-a-port"`,
+		"DB_HOST is required",
+		"DB_NAME is required",
+		"JWT_KEY_GRACE must not be negative",
+		`STORAGE_DRIVER "ftp" must be local or s3`,
+	} {
+		if !slices.Contains(errs, want) {
+			t.Errorf("missing %q in:\n%v", want, errs)
+		}
+	}
+	if slices.Contains(errs, "DB_USER is required") {
+		t.Errorf("DB_USER from the file was not applied:\n%v", errs)
+	}
+}
+
+func TestLoadValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "tls client auth without a CA",
+			args: []string{"-tls-cert-file", "c.pem", "-tls-key-file", "k.pem", "-tls-client-auth", "require"},
+			want: "TLS_CLIENT_CA_FILE is required",
+		},
+		{
+			name: "tls key without a certificate",
+			args: []string{"-tls-key-file", "k.pem"},
+			want: "TLS_CERT_FILE is required",
+		},
+		{
+			name: "unknown tls version",
+			args: []string{"-tls-min-version", "1.1"},
+			want: `TLS_MIN_VERSION "1.1" must be 1.2 or 1.3`,
+		},
+		{
+			name: "missing sslmode",
+			args: nil,
+			want: "DB_SSLMODE is required",
+		},
+		{
+			name: "invalid sslmode",
+			args: []string{"-db-sslmode", "sometimes"},
+			want: `DB_SSLMODE "sometimes" is not a valid sslmode`,
+		},
+		{
+			name: "keys dir without an active kid",
+			args: []string{"-jwt-keys-dir", "keys"},
+			want: "JWT_ACTIVE_KID is required",
+		},
+		{
+			name: "s3 without a bucket",
+			args: []string{"-storage-driver", "s3", "-storage-s3-endpoint", "http://localhost:9000"},
+			want: "S3_BUCKET is required",
+		},
+		{
+			name: "non-positive interval",
+			args: []string{"-jobs-purge-interval", "0s"},
+			want: "PURGE_INTERVAL must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolate(t)
+			t.Setenv("DB_HOST", "localhost")
+			t.Setenv("DB_USER", "app")
+			t.Setenv("DB_NAME", "app")
+
+			errs := loadErrors(t, tt.args...)
+			if !slices.Contains(errs, tt.want) {
+				t.Errorf("missing %q in:\n%v", tt.want, errs)
+			}
+		})
+	}
+}
+
+func TestLoadUnsupportedFile(t *testing.T) {
+	dir := isolate(t)
+	writeFile(t, filepath.Join(dir, "config.json"), "{}")
+	if _, err := Load([]string{"-config", "config.json"}); err == nil {
+		t.Error("Load accepted a .json config file")
+	}
+}
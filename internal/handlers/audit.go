@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"gin-crud/ent/auditentry"
+	"gin-crud/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+)
+
+// GetAuditEntries lists audit log entries, newest first.
+// Supports ?entity_type=&entity_id=&actor_id=&from=&to= (RFC 3339) and offset pagination.
+func (h *Handler) GetAuditEntries(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("GetAuditEntries called by user: %s (%s)", username, email)
+
+	params, err := parsePageParams(c)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if params.Cursor != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "cursor pagination is not supported for the audit log"})
+		return
+	}
+
+	var preds []predicate.AuditEntry
+	if v := c.Query("entity_type"); v != "" {
+		preds = append(preds, auditentry.EntityType(v))
+	}
+	if v := c.Query("entity_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "entity_id must be an integer"})
+			return
+		}
+		preds = append(preds, auditentry.EntityID(id))
+	}
+	if v := c.Query("actor_id"); v != "" {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "actor_id must be an integer"})
+			return
+		}
+		preds = append(preds, auditentry.ActorID(id))
+	}
+	if v := c.Query("from"); v != "" {
+		from, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "from must be an RFC 3339 timestamp"})
+			return
+		}
+		preds = append(preds, auditentry.CreatedAtGTE(from))
+	}
+	if v := c.Query("to"); v != "" {
+		to, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "to must be an RFC 3339 timestamp"})
+			return
+		}
+		preds = append(preds, auditentry.CreatedAtLT(to))
+	}
+
+	ctx := c.Request.Context()
+	query := h.Client.AuditEntry.Query().Where(preds...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve audit log"})
+		h.Logger.Printf("Error counting audit entries: %v", err)
+		return
+	}
+
+	entries, err := query.
+		Order(auditentry.ByCreatedAt(sql.OrderDesc()), auditentry.ByID(sql.OrderDesc())).
+		Offset(params.Offset).
+		Limit(params.Limit).
+		All(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve audit log"})
+		h.Logger.Printf("Error retrieving audit entries: %v", err)
+		return
+	}
+
+	limit := strconv.Itoa(params.Limit)
+	links := map[string]string{
+		"first": pageURL(c, map[string]string{"limit": limit}, "offset"),
+	}
+	if params.Offset+len(entries) < total {
+		links["next"] = pageURL(c, map[string]string{"limit": limit, "offset": strconv.Itoa(params.Offset + params.Limit)})
+	}
+	if params.Offset > 0 {
+		links["prev"] = pageURL(c, map[string]string{"limit": limit, "offset": strconv.Itoa(max(params.Offset-params.Limit, 0))})
+	}
+	setLinkHeader(c, links)
+
+	c.JSON(http.StatusOK, pageResponse{
+		Data:   entries,
+		Total:  total,
+		Limit:  params.Limit,
+		Offset: &params.Offset,
+	})
+}
@@ -1,22 +1,16 @@
 package handlers
 
 import (
-	"context"
-	"log"
 	"net/http"
-	"os"
-	"time"
 
 	"gin-crud/ent"
 	"gin-crud/ent/user"
-	"gin-crud/internal/models"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
 )
 
-func RegisterUser(c *gin.Context) {
+func (h *Handler) RegisterUser(c *gin.Context) {
 	// Define a struct to bind the incoming request body to
 	var user struct {
 		Username string `json:"username" binding:"required"`
@@ -40,18 +34,27 @@ func RegisterUser(c *gin.Context) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to hash password"})
-		log.Printf("Error hashing password: %v", err)
+		h.Logger.Printf("Error hashing password: %v", err)
 		return
 	}
 
-	// Create the new user in the database
-	ctx := context.Background()
-	createdUser, err := models.Client.User.
-		Create().
-		SetUsername(user.Username).
-		SetEmail(user.Email).
-		SetPassword(string(hashedPassword)).
-		Save(ctx)
+	// Create the new user in the database together with its audit entry and
+	// an access token and a refresh token
+	ctx := c.Request.Context()
+	var tokens *tokenPair
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		createdUser, err := tx.User.
+			Create().
+			SetUsername(user.Username).
+			SetEmail(user.Email).
+			SetPassword(string(hashedPassword)).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		tokens, err = h.issueTokens(ctx, tx.Client(), createdUser, "")
+		return err
+	})
 
 	if err != nil {
 		if ent.IsConstraintError(err) {
@@ -59,42 +62,20 @@ func RegisterUser(c *gin.Context) {
 			return
 		}
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user"})
-		log.Printf("Error registering user: %v", err)
-		return
-	}
-
-	// Generate a JWT token for the newly registered user
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Server configuration error"})
+		h.Logger.Printf("Error registering user: %v", err)
 		return
 	}
 
-	// Create a JWT token with user details and expiration time
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub":      createdUser.ID,
-		"username": createdUser.Username,
-		"email":    createdUser.Email,
-		"iat":      time.Now().Unix(),
-		"exp":      time.Now().Add(time.Hour * 24).Unix(),
-	})
-
-	// Sign the token with the secret
-	tokenString, err := token.SignedString([]byte(secret))
-	if err != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
-		log.Printf("Error generating token: %v", err)
-		return
-	}
-
-	// Return the response with a success message and the JWT token
+	// Return the response with a success message and the tokens
 	c.JSON(http.StatusCreated, gin.H{
-		"message": "User registered successfully",
-		"token":   tokenString,
+		"message":       "User registered successfully",
+		"token":         tokens.Token,
+		"refresh_token": tokens.RefreshToken,
+		"expires_in":    tokens.ExpiresIn,
 	})
 }
 
-func LoginUser(c *gin.Context) {
+func (h *Handler) LoginUser(c *gin.Context) {
 	var credentials struct {
 		Username string `json:"username" binding:"required"`
 		Password string `json:"password" binding:"required"`
@@ -107,8 +88,8 @@ func LoginUser(c *gin.Context) {
 	}
 
 	// Query the user by username
-	ctx := context.Background()
-	dbUser, err := models.Client.User.
+	ctx := c.Request.Context()
+	dbUser, err := h.Client.User.
 		Query().
 		Where(user.Username(credentials.Username)).
 		Only(ctx)
@@ -119,7 +100,7 @@ func LoginUser(c *gin.Context) {
 			return
 		}
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to login"})
-		log.Printf("Error fetching user: %v", err)
+		h.Logger.Printf("Error fetching user: %v", err)
 		return
 	}
 
@@ -129,30 +110,20 @@ func LoginUser(c *gin.Context) {
 		return
 	}
 
-	// Generate a JWT token if the login is successful
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Server configuration error"})
-		return
-	}
-
-	// Create a new JWT token with claims
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub":      dbUser.ID,
-		"username": dbUser.Username,
-		"email":    dbUser.Email,
-		"iat":      time.Now().Unix(),
-		"exp":      time.Now().Add(time.Hour * 24).Unix(),
-	})
-
-	// Sign the JWT token with the secret key
-	tokenString, err := token.SignedString([]byte(secret))
+	// Issue an access token and a refresh token if the login is successful
+	tokens, err := h.issueTokens(ctx, h.Client, dbUser, "")
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
-		log.Printf("Error generating token: %v", err)
+		h.Logger.Printf("Error generating token: %v", err)
 		return
 	}
 
-	// Return the generated token in the response
-	c.JSON(http.StatusOK, gin.H{"token": tokenString})
+	// Return the generated tokens in the response
+	c.JSON(http.StatusOK, tokens)
+}
+
+// JWKS publishes the public keys used to verify access tokens
+func (h *Handler) JWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.Keys.JWKS())
 }
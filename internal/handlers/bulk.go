@@ -0,0 +1,420 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"gin-crud/ent"
+	"gin-crud/ent/item"
+	"gin-crud/ent/user"
+	"gin-crud/internal/money"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// maxBulkRows caps the number of rows a single bulk request may touch
+	maxBulkRows = 1000
+
+	bulkModeAtomic  = "atomic"
+	bulkModePartial = "partial"
+)
+
+// bulkResult reports the outcome of a single row of a bulk request
+type bulkResult struct {
+	Index  int           `json:"index"`
+	ID     int64         `json:"id,omitempty"`
+	Status int           `json:"status"`
+	Error  string        `json:"error,omitempty"`
+	Item   *itemResponse `json:"item,omitempty"`
+}
+
+// bulkResponse is the envelope returned by the bulk endpoints
+type bulkResponse struct {
+	Mode      string       `json:"mode"`
+	Succeeded int          `json:"succeeded"`
+	Failed    int          `json:"failed"`
+	Results   []bulkResult `json:"results"`
+}
+
+// bulkMode reads ?mode=atomic|partial, defaulting to atomic
+func bulkMode(c *gin.Context) (string, error) {
+	switch mode := c.DefaultQuery("mode", bulkModeAtomic); mode {
+	case bulkModeAtomic, bulkModePartial:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown mode %q", mode)
+	}
+}
+
+// finishBulk commits or rolls back tx depending on the mode and the results,
+// then writes the response. okStatus is used when every row succeeded.
+func (h *Handler) finishBulk(c *gin.Context, tx *ent.Tx, mode string, results []bulkResult, okStatus int) {
+	resp := bulkResponse{Mode: mode, Results: results}
+	for _, r := range results {
+		if r.Error != "" {
+			resp.Failed++
+		} else {
+			resp.Succeeded++
+		}
+	}
+
+	if mode == bulkModeAtomic && resp.Failed > 0 {
+		// All-or-nothing: drop every change and report which rows failed
+		tx.Rollback()
+		for i := range resp.Results {
+			resp.Results[i].Item = nil
+		}
+		resp.Succeeded = 0
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, resp)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to apply bulk operation"})
+		h.Logger.Printf("Error committing bulk operation: %v", err)
+		return
+	}
+
+	status := okStatus
+	if resp.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	c.JSON(status, resp)
+}
+
+// loadWritableItems fetches the items with the given IDs and reports, per ID,
+// whether the caller may modify it. Missing items are absent from the map.
+func (h *Handler) loadWritableItems(ctx context.Context, c *gin.Context, ids []int64) (map[int64]bool, error) {
+	rows, err := h.Client.Item.Query().Where(item.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	admin := c.GetString("role") == user.RoleAdmin.String()
+	userID := c.GetInt("userID")
+	writable := make(map[int64]bool, len(rows))
+	for _, row := range rows {
+		writable[row.ID] = admin || row.OwnerID == userID
+	}
+	return writable, nil
+}
+
+// BulkCreateItems creates many items in one transaction using ItemCreateBulk.
+// In atomic mode any invalid row rejects the whole request; in partial mode
+// the valid rows are created and the invalid ones reported.
+func (h *Handler) BulkCreateItems(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("BulkCreateItems called by user: %s (%s)", username, email)
+
+	mode, err := bulkMode(c)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var body struct {
+		Items []struct {
+			Name        string `json:"name"`
+			Price       int    `json:"price"`
+			Currency    string `json:"currency"`
+			Description string `json:"description"`
+			Stock       int    `json:"stock"`
+		} `json:"items" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	if len(body.Items) == 0 || len(body.Items) > maxBulkRows {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Between 1 and %d items are required", maxBulkRows)})
+		return
+	}
+
+	ctx := c.Request.Context()
+	tx, err := h.Client.Tx(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to create items"})
+		h.Logger.Printf("Error starting transaction: %v", err)
+		return
+	}
+	defer tx.Rollback()
+
+	results := make([]bulkResult, len(body.Items))
+	var builders []*ent.ItemCreate
+	var indexes []int
+	for i, row := range body.Items {
+		results[i] = bulkResult{Index: i, Status: http.StatusCreated}
+		switch {
+		case row.Name == "":
+			results[i].Status, results[i].Error = http.StatusUnprocessableEntity, "Name is required"
+			continue
+		case row.Stock < 0:
+			results[i].Status, results[i].Error = http.StatusUnprocessableEntity, "Stock cannot be negative"
+			continue
+		}
+		if row.Currency == "" {
+			row.Currency = money.DefaultCurrency
+		}
+		if err := money.ValidateCurrency(row.Currency); err != nil {
+			results[i].Status, results[i].Error = http.StatusUnprocessableEntity, err.Error()
+			continue
+		}
+
+		builders = append(builders, tx.Item.
+			Create().
+			SetName(row.Name).
+			SetPrice(row.Price).
+			SetCurrency(row.Currency).
+			SetDescription(row.Description).
+			SetStock(row.Stock).
+			SetOwnerID(c.GetInt("userID")))
+		indexes = append(indexes, i)
+	}
+
+	if len(builders) > 0 && (mode == bulkModePartial || len(builders) == len(body.Items)) {
+		created, err := tx.Item.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to create items"})
+			h.Logger.Printf("Error bulk creating items: %v", err)
+			return
+		}
+		for j, it := range created {
+			results[indexes[j]].ID = it.ID
+			results[indexes[j]].Item = newItemResponse(it)
+		}
+	}
+
+	h.finishBulk(c, tx, mode, results, http.StatusCreated)
+}
+
+// BulkUpdateItems applies a JSON Merge Patch to many items in one transaction.
+// Each row carries the item "id", an optional expected "version" and the
+// fields to change.
+func (h *Handler) BulkUpdateItems(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("BulkUpdateItems called by user: %s (%s)", username, email)
+
+	mode, err := bulkMode(c)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var body struct {
+		Items []map[string]json.RawMessage `json:"items" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	if len(body.Items) == 0 || len(body.Items) > maxBulkRows {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Between 1 and %d items are required", maxBulkRows)})
+		return
+	}
+
+	type row struct {
+		id      int64
+		version *int
+		patch   *itemPatch
+	}
+	results := make([]bulkResult, len(body.Items))
+	rows := make([]*row, len(body.Items))
+	var ids []int64
+	for i, doc := range body.Items {
+		results[i] = bulkResult{Index: i, Status: http.StatusOK}
+		r, err := func() (*row, error) {
+			r := &row{}
+			if err := json.Unmarshal(doc["id"], &r.id); err != nil || r.id <= 0 {
+				return nil, errors.New("id is required")
+			}
+			if raw, ok := doc["version"]; ok {
+				if err := json.Unmarshal(raw, &r.version); err != nil {
+					return r, errors.New("version must be an integer")
+				}
+			}
+			delete(doc, "id")
+			delete(doc, "version")
+
+			var err error
+			if r.patch, err = mergePatchFromObject(doc); err != nil {
+				return r, err
+			}
+			return r, r.patch.validate()
+		}()
+		if r != nil {
+			results[i].ID = r.id
+		}
+		if err != nil {
+			results[i].Status, results[i].Error = http.StatusUnprocessableEntity, err.Error()
+			continue
+		}
+		rows[i] = r
+		ids = append(ids, r.id)
+	}
+
+	ctx := c.Request.Context()
+	writable, err := h.loadWritableItems(ctx, c, ids)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update items"})
+		h.Logger.Printf("Error retrieving items: %v", err)
+		return
+	}
+
+	tx, err := h.Client.Tx(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update items"})
+		h.Logger.Printf("Error starting transaction: %v", err)
+		return
+	}
+	defer tx.Rollback()
+
+	for i, r := range rows {
+		if r == nil {
+			continue
+		}
+		allowed, found := writable[r.id]
+		switch {
+		case !found:
+			results[i].Status, results[i].Error = http.StatusNotFound, "Item not found"
+			continue
+		case !allowed:
+			results[i].Status, results[i].Error = http.StatusForbidden, "You do not have permission to modify this item"
+			continue
+		}
+
+		update := tx.Item.
+			UpdateOneID(r.id).
+			Where(versionPredicates(r.version)...).
+			AddVersion(1)
+		updated, err := r.patch.apply(update).Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				// The item existed a moment ago, so its version moved
+				results[i].Status, results[i].Error = http.StatusPreconditionFailed, "Item has been modified"
+				continue
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update items"})
+			h.Logger.Printf("Error bulk updating items: %v", err)
+			return
+		}
+		results[i].Item = newItemResponse(updated)
+	}
+
+	h.finishBulk(c, tx, mode, results, http.StatusOK)
+}
+
+// BulkDeleteItems soft-deletes many items in one transaction.
+// Body: {"ids": [1, 2]}, or {"items": [{"id": 1, "version": 3}]} to delete an
+// item only while it still has the version the client saw.
+func (h *Handler) BulkDeleteItems(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("BulkDeleteItems called by user: %s (%s)", username, email)
+
+	mode, err := bulkMode(c)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	type row struct {
+		ID      int64 `json:"id"`
+		Version *int  `json:"version"`
+	}
+	var body struct {
+		IDs   []int64 `json:"ids"`
+		Items []row   `json:"items"`
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil || (body.IDs == nil) == (body.Items == nil) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body, send either ids or items"})
+		return
+	}
+	// Plain ids are rows without a version
+	rows := body.Items
+	for _, id := range body.IDs {
+		rows = append(rows, row{ID: id})
+	}
+
+	if len(rows) == 0 || len(rows) > maxBulkRows {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Between 1 and %d ids are required", maxBulkRows)})
+		return
+	}
+
+	ids := make([]int64, len(rows))
+	for i, r := range rows {
+		ids[i] = r.ID
+	}
+
+	ctx := c.Request.Context()
+	writable, err := h.loadWritableItems(ctx, c, ids)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete items"})
+		h.Logger.Printf("Error retrieving items: %v", err)
+		return
+	}
+
+	tx, err := h.Client.Tx(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete items"})
+		h.Logger.Printf("Error starting transaction: %v", err)
+		return
+	}
+	defer tx.Rollback()
+
+	results := make([]bulkResult, len(rows))
+	for i, r := range rows {
+		id := r.ID
+		results[i] = bulkResult{Index: i, ID: id, Status: http.StatusNoContent}
+		allowed, found := writable[id]
+		switch {
+		case !found:
+			results[i].Status, results[i].Error = http.StatusNotFound, "Item not found"
+			continue
+		case !allowed:
+			results[i].Status, results[i].Error = http.StatusForbidden, "You do not have permission to modify this item"
+			continue
+		}
+
+		err := tx.Item.
+			DeleteOneID(id).
+			Where(versionPredicates(r.Version)...).
+			Exec(ctx)
+		if ent.IsNotFound(err) {
+			// Listed twice or deleted concurrently, unless the item is still
+			// there and only its version moved
+			exists, err := tx.Item.Query().Where(item.ID(id)).Exist(ctx)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete items"})
+				h.Logger.Printf("Error retrieving item: %v", err)
+				return
+			}
+			if exists {
+				results[i].Status, results[i].Error = http.StatusPreconditionFailed, "Item has been modified"
+			} else {
+				results[i].Status, results[i].Error = http.StatusNotFound, "Item not found"
+			}
+			continue
+		}
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete items"})
+			h.Logger.Printf("Error bulk deleting items: %v", err)
+			return
+		}
+	}
+
+	h.finishBulk(c, tx, mode, results, http.StatusOK)
+}
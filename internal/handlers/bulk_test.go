@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gin-crud/ent/item"
+)
+
+func bulkDelete(h *Handler, mode, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodDelete, "/items/bulk?mode="+mode, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return serve("/items/bulk", req, asAdmin, h.BulkDeleteItems)
+}
+
+func TestBulkDeleteItemsChecksVersions(t *testing.T) {
+	for _, tc := range []struct {
+		mode       string
+		wantStatus int
+		wantLeft   int
+	}{
+		{bulkModePartial, http.StatusMultiStatus, 1},
+		{bulkModeAtomic, http.StatusUnprocessableEntity, 2},
+	} {
+		t.Run(tc.mode, func(t *testing.T) {
+			h := newTestHandler(t)
+			fresh := createTestItem(t, h, "Keyboard", "")
+			stale := createTestItem(t, h, "Mouse", "")
+			// Someone else updates the mouse after the client read it
+			h.Client.Item.UpdateOne(stale).SetStock(3).AddVersion(1).ExecX(t.Context())
+
+			body := fmt.Sprintf(`{"items": [{"id": %d, "version": %d}, {"id": %d, "version": %d}]}`,
+				fresh.ID, fresh.Version, stale.ID, stale.Version)
+			w := bulkDelete(h, tc.mode, body)
+			if w.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d, body %s", w.Code, tc.wantStatus, w.Body)
+			}
+
+			var resp bulkResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatal(err)
+			}
+			if got := resp.Results[1].Status; got != http.StatusPreconditionFailed {
+				t.Errorf("stale row status = %d, want %d", got, http.StatusPreconditionFailed)
+			}
+			if n := h.Client.Item.Query().CountX(t.Context()); n != tc.wantLeft {
+				t.Errorf("%d items left, want %d", n, tc.wantLeft)
+			}
+			if !h.Client.Item.Query().Where(item.ID(stale.ID)).ExistX(t.Context()) {
+				t.Error("the stale item was deleted")
+			}
+		})
+	}
+}
+
+func TestBulkDeleteItemsBody(t *testing.T) {
+	h := newTestHandler(t)
+	it := createTestItem(t, h, "Keyboard", "")
+
+	for _, tc := range []struct {
+		name, body string
+		want       int
+	}{
+		{"neither ids nor items", `{}`, http.StatusBadRequest},
+		{"both ids and items", fmt.Sprintf(`{"ids": [%d], "items": [{"id": %d}]}`, it.ID, it.ID), http.StatusBadRequest},
+		{"empty ids", `{"ids": []}`, http.StatusBadRequest},
+		{"ids without versions", fmt.Sprintf(`{"ids": [%d, 999]}`, it.ID), http.StatusMultiStatus},
+	} {
+		if w := bulkDelete(h, bulkModePartial, tc.body); w.Code != tc.want {
+			t.Errorf("%s: status = %d, want %d, body %s", tc.name, w.Code, tc.want, w.Body)
+		}
+	}
+}
@@ -0,0 +1,360 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"gin-crud/ent"
+	"gin-crud/ent/category"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Errors that end a category move before anything is written
+var (
+	errCategoryCycle          = errors.New("category cannot be moved below itself")
+	errParentCategoryNotFound = errors.New("parent category not found")
+)
+
+// categoryInput is the request body for creating and updating categories
+type categoryInput struct {
+	Name     string `json:"name" binding:"required"`
+	Slug     string `json:"slug" binding:"required"`
+	ParentID *int   `json:"parent_id"`
+}
+
+// GetCategories lists all categories ordered by name.
+// Pass ?parent_id= to list the direct children of a category, or ?root=true for top-level ones.
+func (h *Handler) GetCategories(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("GetCategories called by user: %s (%s)", username, email)
+
+	query := h.Client.Category.Query()
+	if v := c.Query("parent_id"); v != "" {
+		parentID, err := strconv.Atoi(v)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid parent_id"})
+			return
+		}
+		query.Where(category.ParentID(parentID))
+	} else if c.Query("root") == "true" {
+		query.Where(category.ParentIDIsNil())
+	}
+
+	categories, err := query.Order(category.ByName()).All(c.Request.Context())
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve categories"})
+		h.Logger.Printf("Error retrieving categories: %v", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, categories)
+}
+
+// GetCategory retrieves a category together with its direct children
+func (h *Handler) GetCategory(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("GetCategory called by user: %s (%s)", username, email)
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	cat, err := h.Client.Category.
+		Query().
+		Where(category.ID(id)).
+		WithChildren(func(q *ent.CategoryQuery) {
+			q.Order(category.ByName())
+		}).
+		Only(c.Request.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Category not found"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve category"})
+		h.Logger.Printf("Error retrieving category: %v", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, cat)
+}
+
+// CreateCategory creates a new category, optionally under a parent
+func (h *Handler) CreateCategory(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("CreateCategory called by user: %s (%s)", username, email)
+
+	var input categoryInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	if input.ParentID != nil {
+		exists, err := h.Client.Category.Query().Where(category.ID(*input.ParentID)).Exist(ctx)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to create category"})
+			h.Logger.Printf("Error retrieving parent category: %v", err)
+			return
+		}
+		if !exists {
+			c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": "Parent category not found"})
+			return
+		}
+	}
+
+	created, err := h.Client.Category.
+		Create().
+		SetName(input.Name).
+		SetSlug(input.Slug).
+		SetNillableParentID(input.ParentID).
+		Save(ctx)
+	if err != nil {
+		h.respondCategoryWriteError(c, err, "Failed to create category")
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+// UpdateCategory renames or moves a category. A category cannot be moved
+// below itself or one of its descendants.
+func (h *Handler) UpdateCategory(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("UpdateCategory called by user: %s (%s)", username, email)
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input categoryInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	var updated *ent.Category
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		update := tx.Category.
+			UpdateOneID(id).
+			SetName(input.Name).
+			SetSlug(input.Slug)
+		if input.ParentID == nil {
+			update.ClearParentID()
+		} else {
+			parents, err := lockCategoryTree(ctx, tx)
+			if err != nil {
+				return err
+			}
+			if _, ok := parents[*input.ParentID]; !ok {
+				return errParentCategoryNotFound
+			}
+			if movesBelowItself(parents, id, *input.ParentID) {
+				return errCategoryCycle
+			}
+			update.SetParentID(*input.ParentID)
+		}
+		var err error
+		updated, err = update.Save(ctx)
+		return err
+	})
+	switch {
+	case errors.Is(err, errCategoryCycle):
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": "A category cannot be moved below itself"})
+		return
+	case errors.Is(err, errParentCategoryNotFound):
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": "Parent category not found"})
+		return
+	case err != nil:
+		h.respondCategoryWriteError(c, err, "Failed to update category")
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeleteCategory deletes a category that has no subcategories.
+// Items in the category are kept and simply lose it.
+func (h *Handler) DeleteCategory(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("DeleteCategory called by user: %s (%s)", username, email)
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	hasChildren, err := h.Client.Category.Query().Where(category.ParentID(id)).Exist(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete category"})
+		h.Logger.Printf("Error checking subcategories: %v", err)
+		return
+	}
+	if hasChildren {
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "Category has subcategories, move or delete them first"})
+		return
+	}
+
+	if err := h.Client.Category.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Category not found"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete category"})
+		h.Logger.Printf("Error deleting category: %v", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SetItemCategories replaces the categories of an item.
+// Body: {"ids": [1, 2]}; an empty list removes the item from every category.
+// The If-Match header must carry the item's current ETag.
+func (h *Handler) SetItemCategories(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("SetItemCategories called by user: %s (%s)", username, email)
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input struct {
+		IDs []int `json:"ids"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil || input.IDs == nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	version, ok := parseIfMatch(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, ok := h.authorizeItemWrite(ctx, c, id); !ok {
+		return
+	}
+
+	found, err := h.Client.Category.Query().Where(category.IDIn(input.IDs...)).Count(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update categories"})
+		h.Logger.Printf("Error retrieving categories: %v", err)
+		return
+	}
+	if found != len(uniqueInts(input.IDs)) {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": "One or more categories do not exist"})
+		return
+	}
+
+	// Categories are part of the item, so replacing them is a new version
+	updated, err := h.Client.Item.
+		UpdateOneID(id).
+		Where(versionPredicates(version)...).
+		ClearCategories().
+		AddCategoryIDs(uniqueInts(input.IDs)...).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			h.respondWriteMiss(ctx, c, id)
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update categories"})
+		h.Logger.Printf("Error updating item categories: %v", err)
+		return
+	}
+
+	categories, err := updated.QueryCategories().Order(category.ByName()).All(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve categories"})
+		h.Logger.Printf("Error retrieving item categories: %v", err)
+		return
+	}
+
+	c.Header("ETag", itemETag(updated))
+	c.JSON(http.StatusOK, categories)
+}
+
+// lockCategoryTree locks every category until tx ends and returns the parent
+// of each. Moves are rare and the tree is small, so serializing them on the
+// whole table keeps two concurrent moves from forming a cycle together.
+func lockCategoryTree(ctx context.Context, tx *ent.Tx) (map[int]*int, error) {
+	var rows []struct {
+		ID       int  `json:"id"`
+		ParentID *int `json:"parent_id"`
+	}
+	err := tx.Category.
+		Query().
+		Order(category.ByID()).
+		Select(category.FieldID, category.FieldParentID).
+		Modify(forUpdate).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	parents := make(map[int]*int, len(rows))
+	for _, r := range rows {
+		parents[r.ID] = r.ParentID
+	}
+	return parents, nil
+}
+
+// movesBelowItself reports whether putting category id under parent would
+// make it its own ancestor. The walk up from parent is capped at the size of
+// the tree, so it also ends if the stored tree already has a cycle.
+func movesBelowItself(parents map[int]*int, id, parent int) bool {
+	cur := parent
+	for range len(parents) + 1 {
+		if cur == id {
+			return true
+		}
+		next := parents[cur]
+		if next == nil {
+			return false
+		}
+		cur = *next
+	}
+	return true
+}
+
+// respondCategoryWriteError maps ent errors from a category write to a response
+func (h *Handler) respondCategoryWriteError(c *gin.Context, err error, message string) {
+	switch {
+	case ent.IsNotFound(err):
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Category not found"})
+	case ent.IsValidationError(err):
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Name is required and slug must be lowercase letters, digits and dashes"})
+	case ent.IsConstraintError(err):
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "A category with this slug already exists"})
+	default:
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": message})
+		h.Logger.Printf("Error writing category: %v", err)
+	}
+}
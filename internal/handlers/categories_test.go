@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUpdateCategoryRejectsCycles(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := t.Context()
+	a := h.Client.Category.Create().SetName("A").SetSlug("a").SaveX(ctx)
+	a1 := h.Client.Category.Create().SetName("A1").SetSlug("a1").SetParentID(a.ID).SaveX(ctx)
+	a2 := h.Client.Category.Create().SetName("A2").SetSlug("a2").SetParentID(a1.ID).SaveX(ctx)
+	b := h.Client.Category.Create().SetName("B").SetSlug("b").SaveX(ctx)
+
+	move := func(id int, slug string, parent int) int {
+		body := fmt.Sprintf(`{"name": %q, "slug": %q, "parent_id": %d}`, strings.ToUpper(slug), slug, parent)
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/categories/%d", id), strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		return serve("/categories/:id", req, h.UpdateCategory).Code
+	}
+
+	for _, tc := range []struct {
+		name       string
+		id         int
+		slug       string
+		parent     int
+		wantStatus int
+	}{
+		{"below itself", a.ID, "a", a.ID, http.StatusUnprocessableEntity},
+		{"below its grandchild", a.ID, "a", a2.ID, http.StatusUnprocessableEntity},
+		{"missing parent", a.ID, "a", 999, http.StatusUnprocessableEntity},
+		{"missing category", 999, "x", a.ID, http.StatusNotFound},
+		{"below another tree", a.ID, "a", b.ID, http.StatusOK},
+		{"old root below its new descendant", b.ID, "b", a2.ID, http.StatusUnprocessableEntity},
+	} {
+		if got := move(tc.id, tc.slug, tc.parent); got != tc.wantStatus {
+			t.Errorf("%s: status = %d, want %d", tc.name, got, tc.wantStatus)
+		}
+	}
+}
+
+func TestCategoryFilterEndsOnCycle(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := t.Context()
+	a := h.Client.Category.Create().SetName("A").SetSlug("a").SaveX(ctx)
+	b := h.Client.Category.Create().SetName("B").SetSlug("b").SetParentID(a.ID).SaveX(ctx)
+	it := createTestItem(t, h, "Keyboard", "")
+	h.Client.Category.UpdateOne(b).AddItems(it).ExecX(ctx)
+
+	// Corrupt the tree behind the handlers' back
+	h.Client.Category.UpdateOne(a).SetParentID(b.ID).ExecX(ctx)
+
+	req := httptest.NewRequest(http.MethodGet, "/items?category=a", nil)
+	w := serve("/items", req, h.GetItems)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"name":"Keyboard"`) {
+		t.Errorf("status = %d, body %s", w.Code, w.Body)
+	}
+}
+
+func TestMovesBelowItself(t *testing.T) {
+	one, two, three := 1, 2, 3
+	for _, tc := range []struct {
+		name       string
+		parents    map[int]*int
+		id, parent int
+		want       bool
+	}{
+		{"root under root", map[int]*int{1: nil, 2: nil}, 1, 2, false},
+		{"under own child", map[int]*int{1: nil, 2: &one}, 1, 2, true},
+		{"under sibling", map[int]*int{1: nil, 2: &one, 3: &one}, 2, 3, false},
+		{"stored cycle", map[int]*int{1: &two, 2: &three, 3: &two, 4: nil}, 4, 1, true},
+	} {
+		if got := movesBelowItself(tc.parents, tc.id, tc.parent); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"gin-crud/ent"
+
+	"github.com/gin-gonic/gin"
+)
+
+// itemETag returns the strong ETag for the current version of an item
+func itemETag(it *ent.Item) string {
+	return fmt.Sprintf(`"%d"`, it.Version)
+}
+
+// parseIfMatch reads the version the client expects from the If-Match header.
+// A wildcard returns ok with a nil version. When the header is missing or
+// malformed the error response is written and ok is false.
+func parseIfMatch(c *gin.Context) (version *int, ok bool) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		c.AbortWithStatusJSON(http.StatusPreconditionRequired, gin.H{"error": "If-Match header is required"})
+		return nil, false
+	}
+	if header == "*" {
+		return nil, true
+	}
+
+	v, err := strconv.Atoi(strings.Trim(header, `"`))
+	if err != nil || strings.HasPrefix(header, "W/") {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid If-Match header"})
+		return nil, false
+	}
+	return &v, true
+}
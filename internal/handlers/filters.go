@@ -0,0 +1,300 @@
+package handlers
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gin-crud/ent/category"
+	"gin-crud/ent/item"
+	"gin-crud/ent/predicate"
+	"gin-crud/ent/tag"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// reservedParams are query parameters that are not treated as field filters
+var reservedParams = map[string]bool{
+	"limit":  true,
+	"offset": true,
+	"cursor": true,
+	"sort":   true,
+	"q":      true,
+
+	"currency":        true,
+	"include_deleted": true,
+}
+
+// filterKey matches "field" and "field[op]"
+var filterKey = regexp.MustCompile(`^([a-z_]+)(?:\[([a-z]+)\])?$`)
+
+// filterOp builds a predicate from a raw query-string value
+type filterOp func(value string) (predicate.Item, error)
+
+// itemFilters whitelists the fields and operators clients may filter items on
+var itemFilters = map[string]map[string]filterOp{
+	item.FieldID: {
+		"eq":  int64Op(item.IDEQ),
+		"ne":  int64Op(item.IDNEQ),
+		"gt":  int64Op(item.IDGT),
+		"gte": int64Op(item.IDGTE),
+		"lt":  int64Op(item.IDLT),
+		"lte": int64Op(item.IDLTE),
+		"in":  int64ListOp(item.IDIn),
+	},
+	item.FieldName: {
+		"eq":        stringOp(item.NameEQ),
+		"ne":        stringOp(item.NameNEQ),
+		"contains":  stringOp(item.NameContains),
+		"icontains": stringOp(item.NameContainsFold),
+		"prefix":    stringOp(item.NameHasPrefix),
+		"suffix":    stringOp(item.NameHasSuffix),
+		"in":        stringListOp(item.NameIn),
+	},
+	item.FieldPrice: {
+		"eq":  intOp(item.PriceEQ),
+		"ne":  intOp(item.PriceNEQ),
+		"gt":  intOp(item.PriceGT),
+		"gte": intOp(item.PriceGTE),
+		"lt":  intOp(item.PriceLT),
+		"lte": intOp(item.PriceLTE),
+		"in":  intListOp(item.PriceIn),
+	},
+	item.FieldStock: {
+		"eq":  intOp(item.StockEQ),
+		"ne":  intOp(item.StockNEQ),
+		"gt":  intOp(item.StockGT),
+		"gte": intOp(item.StockGTE),
+		"lt":  intOp(item.StockLT),
+		"lte": intOp(item.StockLTE),
+	},
+	item.FieldDescription: {
+		"eq":        stringOp(item.DescriptionEQ),
+		"ne":        stringOp(item.DescriptionNEQ),
+		"contains":  stringOp(item.DescriptionContains),
+		"icontains": stringOp(item.DescriptionContainsFold),
+		"prefix":    stringOp(item.DescriptionHasPrefix),
+		"suffix":    stringOp(item.DescriptionHasSuffix),
+		"null":      boolOp(item.DescriptionIsNil(), item.DescriptionNotNil()),
+		"empty": boolOp(
+			item.Or(item.DescriptionIsNil(), item.DescriptionEQ("")),
+			item.And(item.DescriptionNotNil(), item.DescriptionNEQ("")),
+		),
+	},
+	// Only meaningful together with include_deleted=true
+	item.FieldDeletedAt: {
+		"null": boolOp(item.DeletedAtIsNil(), item.DeletedAtNotNil()),
+	},
+	// category takes slugs and also matches items in their subcategories
+	"category": {
+		"eq": stringOp(func(slug string) predicate.Item { return inCategoryTree(slug) }),
+		"in": stringListOp(inCategoryTree),
+	},
+	// tag names are normalized the way they are stored, so ?tag=Sale finds "sale"
+	"tag": {
+		"eq": stringOp(func(name string) predicate.Item { return item.HasTagsWith(tag.Name(normalizeTagName(name))) }),
+		"in": stringListOp(func(names ...string) predicate.Item {
+			for i, name := range names {
+				names[i] = normalizeTagName(name)
+			}
+			return item.HasTagsWith(tag.NameIn(names...))
+		}),
+	},
+}
+
+// itemSorts whitelists the fields clients may sort items by
+var itemSorts = map[string]func(...sql.OrderTermOption) item.OrderOption{
+	item.FieldID:          item.ByID,
+	item.FieldName:        item.ByName,
+	item.FieldPrice:       item.ByPrice,
+	item.FieldDescription: item.ByDescription,
+	item.FieldStock:       item.ByStock,
+}
+
+// parseItemFilters translates query parameters such as price[gte]=100 or
+// name[contains]=key into item predicates. A bare field=value means eq.
+func parseItemFilters(query map[string][]string) ([]predicate.Item, error) {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		if !reservedParams[key] {
+			keys = append(keys, key)
+		}
+	}
+	// Keep error messages deterministic
+	sort.Strings(keys)
+
+	var preds []predicate.Item
+	for _, key := range keys {
+		m := filterKey.FindStringSubmatch(key)
+		if m == nil {
+			return nil, fmt.Errorf("invalid filter %q", key)
+		}
+		field, op := m[1], m[2]
+		if op == "" {
+			op = "eq"
+		}
+
+		ops, ok := itemFilters[field]
+		if !ok {
+			return nil, fmt.Errorf("unknown filter field %q", field)
+		}
+		build, ok := ops[op]
+		if !ok {
+			return nil, fmt.Errorf("unknown operator %q for field %q", op, field)
+		}
+
+		for _, value := range query[key] {
+			p, err := build(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %s: %v", key, err)
+			}
+			preds = append(preds, p)
+		}
+	}
+	return preds, nil
+}
+
+// parseItemSort translates sort=-price,name into order options.
+// A leading "-" sorts descending. The ID is always appended as a tie-breaker.
+func parseItemSort(value string) ([]item.OrderOption, error) {
+	var orders []item.OrderOption
+	seen := map[string]bool{}
+	for _, term := range strings.Split(value, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		var opts []sql.OrderTermOption
+		if strings.HasPrefix(term, "-") {
+			term = term[1:]
+			opts = append(opts, sql.OrderDesc())
+		}
+
+		by, ok := itemSorts[term]
+		if !ok {
+			return nil, fmt.Errorf("unknown sort field %q", term)
+		}
+		if seen[term] {
+			return nil, fmt.Errorf("duplicate sort field %q", term)
+		}
+		seen[term] = true
+		orders = append(orders, by(opts...))
+	}
+	if !seen[item.FieldID] {
+		orders = append(orders, item.ByID())
+	}
+	return orders, nil
+}
+
+func stringOp(p func(string) predicate.Item) filterOp {
+	return func(value string) (predicate.Item, error) {
+		return p(value), nil
+	}
+}
+
+func stringListOp(p func(...string) predicate.Item) filterOp {
+	return func(value string) (predicate.Item, error) {
+		return p(strings.Split(value, ",")...), nil
+	}
+}
+
+func intOp(p func(int) predicate.Item) filterOp {
+	return func(value string) (predicate.Item, error) {
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", value)
+		}
+		return p(v), nil
+	}
+}
+
+func intListOp(p func(...int) predicate.Item) filterOp {
+	return func(value string) (predicate.Item, error) {
+		var vs []int
+		for _, part := range strings.Split(value, ",") {
+			v, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not an integer", part)
+			}
+			vs = append(vs, v)
+		}
+		return p(vs...), nil
+	}
+}
+
+func int64Op(p func(int64) predicate.Item) filterOp {
+	return func(value string) (predicate.Item, error) {
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", value)
+		}
+		return p(v), nil
+	}
+}
+
+func int64ListOp(p func(...int64) predicate.Item) filterOp {
+	return func(value string) (predicate.Item, error) {
+		var vs []int64
+		for _, part := range strings.Split(value, ",") {
+			v, err := strconv.ParseInt(part, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not an integer", part)
+			}
+			vs = append(vs, v)
+		}
+		return p(vs...), nil
+	}
+}
+
+// inCategoryTree matches items in any of the categories with the given slugs
+// or in one of their descendants, walking the tree with a recursive CTE
+func inCategoryTree(slugs ...string) predicate.Item {
+	return item.HasCategoriesWith(func(s *sql.Selector) {
+		d := sql.Dialect(s.Dialect())
+		roots, children := d.Table(category.Table), d.Table(category.Table)
+
+		tree := sql.WithRecursive("category_tree", category.FieldID)
+		tree.SetDialect(s.Dialect())
+		tree.As(
+			d.Select(roots.C(category.FieldID)).
+				From(roots).
+				Where(sql.In(roots.C(category.FieldSlug), toAny(slugs)...)).
+				// UNION drops rows already in the tree, so the walk ends even on a cycle
+				Union(
+					d.Select(children.C(category.FieldID)).
+						From(children).
+						Join(tree).
+						On(children.C(category.FieldParentID), tree.C(category.FieldID)),
+				),
+		)
+		s.Where(sql.In(
+			s.C(category.FieldID),
+			d.Select(tree.C(category.FieldID)).From(tree).Prefix(tree),
+		))
+	})
+}
+
+func toAny[T any](vs []T) []any {
+	out := make([]any, len(vs))
+	for i, v := range vs {
+		out[i] = v
+	}
+	return out
+}
+
+// boolOp picks one of two predicates depending on a true/false value
+func boolOp(whenTrue, whenFalse predicate.Item) filterOp {
+	return func(value string) (predicate.Item, error) {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", value)
+		}
+		if b {
+			return whenTrue, nil
+		}
+		return whenFalse, nil
+	}
+}
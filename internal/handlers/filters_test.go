@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// createFilterFixture stores a small catalog:
+//
+//	Keyboard   price 100, stock 0,  "Mechanical keys", electronics > keyboards, sale
+//	Mouse      price 200, stock 5,  "",                electronics,            new
+//	Desk lamp  price 300, stock 10, no description,    furniture,              sale, new
+//	Cable      price 50,  stock 1,  "USB cable", soft-deleted
+func createFilterFixture(t *testing.T, h *Handler) {
+	t.Helper()
+	ctx := t.Context()
+	electronics := h.Client.Category.Create().SetName("Electronics").SetSlug("electronics").SaveX(ctx)
+	keyboards := h.Client.Category.Create().SetName("Keyboards").SetSlug("keyboards").SetParentID(electronics.ID).SaveX(ctx)
+	furniture := h.Client.Category.Create().SetName("Furniture").SetSlug("furniture").SaveX(ctx)
+	sale := h.Client.Tag.Create().SetName("sale").SaveX(ctx)
+	fresh := h.Client.Tag.Create().SetName("new").SaveX(ctx)
+
+	h.Client.Item.Create().SetName("Keyboard").SetPrice(100).SetStock(0).SetDescription("Mechanical keys").
+		AddCategories(keyboards).AddTags(sale).SaveX(ctx)
+	h.Client.Item.Create().SetName("Mouse").SetPrice(200).SetStock(5).SetDescription("").
+		AddCategories(electronics).AddTags(fresh).SaveX(ctx)
+	h.Client.Item.Create().SetName("Desk lamp").SetPrice(300).SetStock(10).
+		AddCategories(furniture).AddTags(sale, fresh).SaveX(ctx)
+	cable := h.Client.Item.Create().SetName("Cable").SetPrice(50).SetStock(1).SetDescription("USB cable").SaveX(ctx)
+	h.Client.Item.DeleteOne(cable).ExecX(ctx)
+
+	if ids := h.Client.Item.Query().IDsX(ctx); len(ids) != 3 || ids[0] != 1 {
+		t.Fatalf("fixture item IDs = %v, want 1 to 3 visible", ids)
+	}
+}
+
+// listItemNames runs GetItems as an admin and returns the names in the page
+func listItemNames(t *testing.T, h *Handler, query string) ([]string, *httptest.ResponseRecorder) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/items?"+query, nil)
+	w := serve("/items", req, asAdmin, h.GetItems)
+	if w.Code != http.StatusOK {
+		return nil, w
+	}
+	var body struct {
+		Data []struct {
+			Name string `json:"name"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, d := range body.Data {
+		names = append(names, d.Name)
+	}
+	return names, w
+}
+
+func TestGetItemsFilters(t *testing.T) {
+	h := newTestHandler(t)
+	createFilterFixture(t, h)
+
+	const (
+		k = "Keyboard"
+		m = "Mouse"
+		l = "Desk lamp"
+		c = "Cable"
+	)
+	cases := []struct {
+		query string
+		want  []string
+	}{
+		{"id=1", []string{k}},
+		{"id[eq]=1", []string{k}},
+		{"id[ne]=1", []string{m, l}},
+		{"id[gt]=1", []string{m, l}},
+		{"id[gte]=2", []string{m, l}},
+		{"id[lt]=2", []string{k}},
+		{"id[lte]=2", []string{k, m}},
+		{"id[in]=1,3", []string{k, l}},
+
+		{"name=Mouse", []string{m}},
+		{"name[ne]=Mouse", []string{k, l}},
+		{"name[contains]=o", []string{k, m}},
+		{"name[icontains]=KEY", []string{k}},
+		{"name[prefix]=Desk", []string{l}},
+		{"name[suffix]=board", []string{k}},
+		{"name[in]=Mouse,Desk%20lamp", []string{m, l}},
+
+		{"price=100", []string{k}},
+		{"price[ne]=100", []string{m, l}},
+		{"price[gt]=100", []string{m, l}},
+		{"price[gte]=200", []string{m, l}},
+		{"price[lt]=200", []string{k}},
+		{"price[lte]=200", []string{k, m}},
+		{"price[in]=100,300", []string{k, l}},
+
+		{"stock=0", []string{k}},
+		{"stock[ne]=0", []string{m, l}},
+		{"stock[gt]=5", []string{l}},
+		{"stock[gte]=5", []string{m, l}},
+		{"stock[lt]=5", []string{k}},
+		{"stock[lte]=5", []string{k, m}},
+
+		{"description=Mechanical%20keys", []string{k}},
+		{"description[ne]=Mechanical%20keys", []string{m}},
+		{"description[contains]=keys", []string{k}},
+		{"description[icontains]=MECH", []string{k}},
+		{"description[prefix]=Mech", []string{k}},
+		{"description[suffix]=keys", []string{k}},
+		{"description[null]=true", []string{l}},
+		{"description[null]=false", []string{k, m}},
+		{"description[empty]=true", []string{m, l}},
+		{"description[empty]=false", []string{k}},
+
+		{"include_deleted=true&deleted_at[null]=false", []string{c}},
+		{"include_deleted=true&deleted_at[null]=true", []string{k, m, l}},
+
+		// Categories match their subcategories too
+		{"category=electronics", []string{k, m}},
+		{"category[eq]=keyboards", []string{k}},
+		{"category[in]=keyboards,furniture", []string{k, l}},
+
+		{"tag=Sale", []string{k, l}},
+		{"tag[eq]=sale", []string{k, l}},
+		{"tag[in]=NEW,missing", []string{m, l}},
+
+		// Filters combine with AND, also when a key repeats
+		{"price[gte]=100&price[lte]=200&tag=sale", []string{k}},
+		{"price[ne]=100&price[ne]=200", []string{l}},
+		{"name=Nothing", []string{}},
+	}
+
+	covered := map[string]bool{}
+	for _, tc := range cases {
+		got, w := listItemNames(t, h, tc.query)
+		if w.Code != http.StatusOK {
+			t.Errorf("%s: status = %d, body %s", tc.query, w.Code, w.Body)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.query, got, tc.want)
+		}
+
+		values, _ := url.ParseQuery(tc.query)
+		for key := range values {
+			if m := filterKey.FindStringSubmatch(key); m != nil {
+				op := m[2]
+				if op == "" {
+					op = "eq"
+				}
+				covered[m[1]+"["+op+"]"] = true
+			}
+		}
+	}
+
+	for field, ops := range itemFilters {
+		for op := range ops {
+			if !covered[field+"["+op+"]"] {
+				t.Errorf("no test case for %s[%s]", field, op)
+			}
+		}
+	}
+}
+
+func TestGetItemsRejectsFilters(t *testing.T) {
+	h := newTestHandler(t)
+
+	for _, tc := range []struct {
+		query, err string
+	}{
+		{"owner_id=1", `unknown filter field "owner_id"`},
+		{"version=1", `unknown filter field "version"`},
+		{"name[gt]=a", `unknown operator "gt" for field "name"`},
+		{"stock[in]=1,2", `unknown operator "in" for field "stock"`},
+		{"deleted_at[eq]=x", `unknown operator "eq" for field "deleted_at"`},
+		{"Name=a", `invalid filter "Name"`},
+		{"price[GTE]=1", `invalid filter "price[GTE]"`},
+		{"price[gte][x]=1", `invalid filter "price[gte][x]"`},
+		{"price=abc", `invalid value for price: "abc" is not an integer`},
+		{"price[in]=1,x", `invalid value for price[in]: "x" is not an integer`},
+		{"id[in]=1,,2", `invalid value for id[in]: "" is not an integer`},
+		{"stock=1.5", `invalid value for stock: "1.5" is not an integer`},
+		{"description[null]=maybe", `invalid value for description[null]: "maybe" is not a boolean`},
+	} {
+		_, w := listItemNames(t, h, tc.query)
+		if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), jsonString(tc.err)) {
+			t.Errorf("%s: status = %d, body %s, want 400 with %q", tc.query, w.Code, w.Body, tc.err)
+		}
+	}
+}
+
+// jsonString returns s as it appears inside a JSON string
+func jsonString(s string) string {
+	raw, _ := json.Marshal(s)
+	return strings.Trim(string(raw), `"`)
+}
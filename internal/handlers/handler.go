@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"gin-crud/ent"
+	"gin-crud/internal/app"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Handler serves the API endpoints. Its methods are gin handlers that use the
+// database client, logger and other services of the App it was built with.
+type Handler struct {
+	*app.App
+}
+
+// New returns a Handler that serves requests with the dependencies of the App a.
+// Handlers share no state, so several can serve different Apps in one process.
+func New(a *app.App) *Handler {
+	return &Handler{App: a}
+}
+
+// withTx runs fn in a transaction and commits it unless fn fails
+func (h *Handler) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := h.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// forUpdate locks the selected rows until the transaction ends. SQLite has no
+// row locks, but it only runs one write transaction at a time anyway.
+func forUpdate(s *sql.Selector) {
+	if s.Dialect() == dialect.Postgres {
+		s.ForUpdate()
+	}
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gin-crud/ent"
+	"gin-crud/ent/enttest"
+	"gin-crud/internal/app"
+	"gin-crud/internal/models"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// newTestHandler returns a Handler backed by a fresh in-memory SQLite database
+// with the same hooks as the real client
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+	models.RegisterHooks(client)
+	return New(&app.App{
+		Client: client,
+		Logger: log.New(io.Discard, "", 0),
+	})
+}
+
+// createTestItem stores an item directly, bypassing the handlers
+func createTestItem(t *testing.T, h *Handler, name, description string) *ent.Item {
+	t.Helper()
+	it, err := h.Client.Item.Create().
+		SetName(name).
+		SetDescription(description).
+		SetPrice(100).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("creating item %q: %v", name, err)
+	}
+	return it
+}
+
+// serve runs req through handlers mounted on a single route and returns the
+// recorded response
+func serve(pattern string, req *http.Request, handlers ...gin.HandlerFunc) *httptest.ResponseRecorder {
+	router := gin.New()
+	router.Handle(req.Method, pattern, handlers...)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// createTestAdmin stores the user that asAdmin authenticates as, for writes
+// that reference the user
+func createTestAdmin(t *testing.T, h *Handler) {
+	t.Helper()
+	err := h.Client.User.Create().
+		SetID(1).
+		SetUsername("admin").
+		SetPassword("not a hash").
+		SetRole("admin").
+		Exec(t.Context())
+	if err != nil {
+		t.Fatalf("creating admin: %v", err)
+	}
+}
+
+// asAdmin stands in for the JWT middleware, authenticating the request as an admin
+func asAdmin(c *gin.Context) {
+	c.Set("userID", 1)
+	c.Set("role", "admin")
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"gin-crud/ent"
+	"gin-crud/ent/itemimage"
+	"gin-crud/internal/images"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// maxImageSize caps the size of an uploaded image
+	maxImageSize = 10 << 20
+	// thumbnailSize is the longest side of generated thumbnails, in pixels
+	thumbnailSize = 256
+)
+
+// imageExtensions maps stored content types to file extensions
+var imageExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+// UploadItemImage attaches a photo to an item from a multipart upload in the
+// "image" field. The type is sniffed from the file contents and a thumbnail
+// is stored next to the original.
+func (h *Handler) UploadItemImage(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("UploadItemImage called by user: %s (%s)", username, email)
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, ok := h.authorizeItemWrite(ctx, c, id); !ok {
+		return
+	}
+
+	// Leave room for the multipart framing around the file
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImageSize+1<<20)
+	file, err := c.FormFile("image")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Image is too large"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "An image is required in the image field"})
+		return
+	}
+	if file.Size > maxImageSize {
+		c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Image is too large"})
+		return
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Failed to read image"})
+		return
+	}
+	data, err := io.ReadAll(f)
+	f.Close()
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Failed to read image"})
+		return
+	}
+
+	img, err := images.Decode(data)
+	if err != nil {
+		if errors.Is(err, images.ErrUnsupported) {
+			c.AbortWithStatusJSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	thumb, thumbType, err := img.Thumbnail(thumbnailSize)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to create thumbnail"})
+		h.Logger.Printf("Error creating thumbnail: %v", err)
+		return
+	}
+
+	name, err := randomToken(16)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to store image"})
+		h.Logger.Printf("Error generating image key: %v", err)
+		return
+	}
+	key := fmt.Sprintf("items/%d/%s.%s", id, name, imageExtensions[img.ContentType])
+	thumbKey := fmt.Sprintf("items/%d/%s_thumb.%s", id, name, imageExtensions[thumbType])
+
+	if err := h.Storage.Put(ctx, key, data, img.ContentType); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to store image"})
+		h.Logger.Printf("Error storing image: %v", err)
+		return
+	}
+	if err := h.Storage.Put(ctx, thumbKey, thumb, thumbType); err != nil {
+		h.deleteStoredFiles(c, key)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to store image"})
+		h.Logger.Printf("Error storing thumbnail: %v", err)
+		return
+	}
+
+	bounds := img.Image.Bounds()
+	created, err := h.Client.ItemImage.
+		Create().
+		SetItemID(id).
+		SetKey(key).
+		SetThumbnailKey(thumbKey).
+		SetURL(h.Storage.URL(key)).
+		SetThumbnailURL(h.Storage.URL(thumbKey)).
+		SetContentType(img.ContentType).
+		SetSize(int64(len(data))).
+		SetWidth(bounds.Dx()).
+		SetHeight(bounds.Dy()).
+		Save(ctx)
+	if err != nil {
+		h.deleteStoredFiles(c, key, thumbKey)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to store image"})
+		h.Logger.Printf("Error saving image: %v", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+// GetItemImages lists the images of an item, oldest first
+func (h *Handler) GetItemImages(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("GetItemImages called by user: %s (%s)", username, email)
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	it, err := h.Client.Item.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve item"})
+		h.Logger.Printf("Error retrieving item: %v", err)
+		return
+	}
+
+	imgs, err := it.QueryImages().Order(itemimage.ByID()).All(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve images"})
+		h.Logger.Printf("Error retrieving images: %v", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, imgs)
+}
+
+// DeleteItemImage removes an image and its stored files
+func (h *Handler) DeleteItemImage(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("DeleteItemImage called by user: %s (%s)", username, email)
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+	imageID, err := strconv.Atoi(c.Param("imageID"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid image ID format"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, ok := h.authorizeItemWrite(ctx, c, id); !ok {
+		return
+	}
+
+	img, err := h.Client.ItemImage.
+		Query().
+		Where(itemimage.ID(imageID), itemimage.ItemID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Image not found"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve image"})
+		h.Logger.Printf("Error retrieving image: %v", err)
+		return
+	}
+
+	if err := h.Client.ItemImage.DeleteOne(img).Exec(ctx); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete image"})
+		h.Logger.Printf("Error deleting image: %v", err)
+		return
+	}
+	h.deleteStoredFiles(c, img.Key, img.ThumbnailKey)
+
+	c.Status(http.StatusNoContent)
+}
+
+// deleteStoredFiles removes files from storage on a best-effort basis.
+// Failures only leave orphaned files behind, so they are logged and ignored.
+func (h *Handler) deleteStoredFiles(c *gin.Context, keys ...string) {
+	for _, key := range keys {
+		if err := h.Storage.Delete(c.Request.Context(), key); err != nil {
+			h.Logger.Printf("Error deleting stored file %s: %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,391 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gin-crud/ent"
+	"gin-crud/ent/item"
+	"gin-crud/ent/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// exportBatchSize is the number of rows read from the database at a time
+	exportBatchSize = 500
+	// maxImportSize caps the size of an uploaded import file
+	maxImportSize = 20 << 20
+)
+
+// csvColumns are the item fields written to and read from CSV files, in order
+var csvColumns = []string{
+	item.FieldID,
+	item.FieldName,
+	item.FieldPrice,
+	item.FieldCurrency,
+	item.FieldDescription,
+	item.FieldStock,
+}
+
+// importLineError reports a rejected line of an import file
+type importLineError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+// importReport summarizes an import
+type importReport struct {
+	DryRun  bool              `json:"dry_run"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Failed  int               `json:"failed"`
+	Errors  []importLineError `json:"errors"`
+}
+
+// ExportItems streams the item catalog as CSV or JSON Lines.
+// Accepts ?format=csv|jsonl and the same filters as GetItems. Rows are read
+// in batches so the whole catalog is never held in memory.
+func (h *Handler) ExportItems(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("ExportItems called by user: %s (%s)", username, email)
+
+	format := c.DefaultQuery("format", "csv")
+	if format != "csv" && format != "jsonl" {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "format must be csv or jsonl"})
+		return
+	}
+
+	query := c.Request.URL.Query()
+	query.Del("format")
+	filters, err := parseItemFilters(query)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var writeRow func(*ent.Item) error
+	var flush func() error
+	switch format {
+	case "csv":
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		w := csv.NewWriter(c.Writer)
+		if err := w.Write(csvColumns); err != nil {
+			return
+		}
+		writeRow = func(it *ent.Item) error {
+			return w.Write([]string{
+				strconv.FormatInt(it.ID, 10),
+				it.Name,
+				strconv.Itoa(it.Price),
+				it.Currency,
+				it.Description,
+				strconv.Itoa(it.Stock),
+			})
+		}
+		flush = func() error {
+			w.Flush()
+			return w.Error()
+		}
+	case "jsonl":
+		c.Header("Content-Type", "application/x-ndjson")
+		enc := json.NewEncoder(c.Writer)
+		writeRow = func(it *ent.Item) error { return enc.Encode(newItemResponse(it)) }
+		flush = func() error { return nil }
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="items.%s"`, format))
+	c.Status(http.StatusOK)
+
+	// Keyset pagination on the ID keeps each batch query cheap
+	ctx := c.Request.Context()
+	var lastID int64
+	for {
+		batch, err := h.Client.Item.
+			Query().
+			Where(filters...).
+			Where(item.IDGT(lastID)).
+			Order(item.ByID()).
+			Limit(exportBatchSize).
+			All(ctx)
+		if err != nil {
+			// Headers are already sent, so the truncated body is all we can do
+			h.Logger.Printf("Error exporting items: %v", err)
+			return
+		}
+
+		for _, it := range batch {
+			if err := writeRow(it); err != nil {
+				h.Logger.Printf("Error writing export row: %v", err)
+				return
+			}
+		}
+		if err := flush(); err != nil {
+			h.Logger.Printf("Error writing export: %v", err)
+			return
+		}
+		c.Writer.Flush()
+
+		if len(batch) < exportBatchSize {
+			return
+		}
+		lastID = batch[len(batch)-1].ID
+	}
+}
+
+// ImportItems loads items from a multipart CSV upload in the "file" field.
+//
+// Form fields:
+//
+//	mapping   optional JSON object mapping item fields to CSV headers, e.g. {"name":"Product"}
+//	dry_run   "true" validates and reports without writing anything
+//	upsert    "name" updates the existing item with the same name instead of creating one
+//
+// Valid lines are applied and invalid ones reported with their line number.
+func (h *Handler) ImportItems(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("ImportItems called by user: %s (%s)", username, email)
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "A CSV file is required in the file field"})
+		return
+	}
+
+	dryRun := c.PostForm("dry_run") == "true"
+	upsert := c.PostForm("upsert")
+	if upsert != "" && upsert != "name" {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "upsert only supports name"})
+		return
+	}
+
+	mapping := map[string]string{}
+	if v := c.PostForm("mapping"); v != "" {
+		if err := json.Unmarshal([]byte(v), &mapping); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "mapping must be a JSON object"})
+			return
+		}
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Failed to read file"})
+		return
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "The file has no CSV header"})
+		return
+	}
+	columns, err := mapColumns(header, mapping)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := c.Request.Context()
+	tx, err := h.Client.Tx(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to import items"})
+		h.Logger.Printf("Error starting transaction: %v", err)
+		return
+	}
+	defer tx.Rollback()
+
+	report := importReport{DryRun: dryRun, Errors: []importLineError{}}
+	fail := func(line int, err error) {
+		report.Failed++
+		report.Errors = append(report.Errors, importLineError{Line: line, Error: err.Error()})
+	}
+
+	admin := c.GetString("role") == user.RoleAdmin.String()
+	userID := c.GetInt("userID")
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				fail(parseErr.Line, err)
+				continue
+			}
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Failed to read file"})
+			return
+		}
+		line, _ := r.FieldPos(0)
+
+		row, err := parseImportRow(record, columns)
+		if err != nil {
+			fail(line, err)
+			continue
+		}
+
+		var existing *ent.Item
+		if upsert == "name" {
+			matches, err := tx.Item.Query().Where(item.Name(*row.Name)).Limit(2).All(ctx)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to import items"})
+				h.Logger.Printf("Error looking up item by name: %v", err)
+				return
+			}
+			if len(matches) > 1 {
+				fail(line, fmt.Errorf("more than one item is named %q", *row.Name))
+				continue
+			}
+			if len(matches) == 1 {
+				existing = matches[0]
+			}
+		}
+
+		if existing != nil {
+			if !admin && existing.OwnerID != userID {
+				fail(line, errors.New("you do not have permission to modify this item"))
+				continue
+			}
+			_, err = row.apply(tx.Item.UpdateOne(existing).AddVersion(1)).Save(ctx)
+			if err == nil {
+				report.Updated++
+			}
+		} else {
+			if row.Price == nil {
+				fail(line, errors.New("price is required for new items"))
+				continue
+			}
+			create := tx.Item.
+				Create().
+				SetName(*row.Name).
+				SetPrice(*row.Price).
+				SetOwnerID(userID)
+			if row.Currency != nil {
+				create.SetCurrency(*row.Currency)
+			}
+			if row.Description != nil {
+				create.SetDescription(*row.Description)
+			}
+			if row.Stock != nil {
+				create.SetStock(*row.Stock)
+			}
+			err = create.Exec(ctx)
+			if err == nil {
+				report.Created++
+			}
+		}
+		if ent.IsValidationError(err) || ent.IsConstraintError(err) {
+			// The row itself is invalid, the rest of the file can still be imported
+			fail(line, err)
+			continue
+		}
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to import items"})
+			h.Logger.Printf("Error importing line %d: %v", line, err)
+			return
+		}
+	}
+
+	if dryRun {
+		// Everything ran against the database, so the report reflects a real
+		// import, but nothing is kept
+		tx.Rollback()
+	} else if err := tx.Commit(); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to import items"})
+		h.Logger.Printf("Error committing import: %v", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// mapColumns resolves the CSV column index of each item field. mapping maps
+// item fields to header names; unmapped fields match headers case-insensitively.
+// The id column is ignored on import.
+func mapColumns(header []string, mapping map[string]string) (map[string]int, error) {
+	for field := range mapping {
+		if field == item.FieldID || !slices.Contains(csvColumns, field) {
+			return nil, fmt.Errorf("unknown field %q in mapping", field)
+		}
+	}
+
+	columns := map[string]int{}
+	for _, field := range csvColumns[1:] {
+		want, ok := mapping[field]
+		if !ok {
+			want = field
+		}
+		for i, h := range header {
+			if strings.EqualFold(strings.TrimSpace(h), want) {
+				columns[field] = i
+				break
+			}
+		}
+		if _, found := columns[field]; !found && ok {
+			return nil, fmt.Errorf("column %q mapped to %s not found in header", want, field)
+		}
+	}
+
+	if _, ok := columns[item.FieldName]; !ok {
+		return nil, errors.New("a name column is required")
+	}
+	return columns, nil
+}
+
+// parseImportRow turns a CSV record into a patch of the mapped fields
+func parseImportRow(record []string, columns map[string]int) (*itemPatch, error) {
+	p := &itemPatch{}
+	for field, i := range columns {
+		if i >= len(record) {
+			continue
+		}
+		value := strings.TrimSpace(record[i])
+
+		switch field {
+		case item.FieldName:
+			p.Name = &value
+		case item.FieldCurrency:
+			if value == "" {
+				continue
+			}
+			value = strings.ToUpper(value)
+			p.Currency = &value
+		case item.FieldDescription:
+			p.Description = &value
+		case item.FieldPrice, item.FieldStock:
+			if value == "" {
+				continue
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be an integer", field)
+			}
+			if field == item.FieldPrice {
+				p.Price = &n
+			} else {
+				p.Stock = &n
+			}
+		}
+	}
+
+	if p.Name == nil {
+		return nil, errors.New("name is required")
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
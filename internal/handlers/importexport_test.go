@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// importRequest builds a multipart import request for csv
+func importRequest(t *testing.T, csv string, fields map[string]string) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fw, err := mw.CreateFormFile("file", "items.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fw.Write([]byte(csv))
+	for k, v := range fields {
+		mw.WriteField(k, v)
+	}
+	mw.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/items/import", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return req
+}
+
+func TestImportItemsReportsBadLines(t *testing.T) {
+	const csv = "name,price,stock\n" +
+		"Keyboard,1999,5\n" + // line 2: created
+		"Mouse,cheap,1\n" + // line 3: price is not an integer
+		"Cable,,3\n" + // line 4: blank price
+		"Stand,500,-1\n" + // line 5: negative stock
+		"Monitor,24999,2\n" // line 6: created
+	const csvWithoutPrice = "name,stock\nLamp,1\n"
+
+	for _, dryRun := range []string{"false", "true"} {
+		t.Run("dry_run="+dryRun, func(t *testing.T) {
+			h := newTestHandler(t)
+			createTestAdmin(t, h)
+
+			w := serve("/items/import", importRequest(t, csv, map[string]string{"dry_run": dryRun}), asAdmin, h.ImportItems)
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, body %s", w.Code, w.Body)
+			}
+			var report importReport
+			if err := json.Unmarshal(w.Body.Bytes(), &report); err != nil {
+				t.Fatal(err)
+			}
+			var lines []int
+			for _, e := range report.Errors {
+				lines = append(lines, e.Line)
+			}
+			if report.Created != 2 || report.Failed != 3 || !reflect.DeepEqual(lines, []int{3, 4, 5}) {
+				t.Errorf("report = %+v, want 2 created and lines 3, 4 and 5 failed", report)
+			}
+
+			want := 2
+			if dryRun == "true" {
+				want = 0
+			}
+			if n := h.Client.Item.Query().CountX(t.Context()); n != want {
+				t.Errorf("stored %d items, want %d", n, want)
+			}
+
+			// A file without a price column cannot create items
+			w = serve("/items/import", importRequest(t, csvWithoutPrice, map[string]string{"dry_run": dryRun}), asAdmin, h.ImportItems)
+			if w.Code != http.StatusOK {
+				t.Fatalf("without price: status = %d, body %s", w.Code, w.Body)
+			}
+			report = importReport{}
+			json.Unmarshal(w.Body.Bytes(), &report)
+			if report.Created != 0 || len(report.Errors) != 1 || report.Errors[0].Error != "price is required for new items" {
+				t.Errorf("without price: report = %+v", report)
+			}
+		})
+	}
+}
@@ -2,43 +2,188 @@ package handlers
 
 import (
 	"context"
-	"log"
 	"net/http"
 	"strconv"
 
 	"gin-crud/ent"
 	"gin-crud/ent/item"
-	"gin-crud/internal/models"
+	"gin-crud/ent/predicate"
+	"gin-crud/ent/schema"
+	"gin-crud/ent/user"
+	"gin-crud/internal/money"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
 )
 
-// GetItems retrieves all items
-func GetItems(c *gin.Context) {
+// GetItems retrieves a page of items.
+// Supports offset pagination (?limit=&offset=) and cursor pagination (?limit=&cursor=),
+// field filters such as ?price[gte]=100&name[contains]=key, ?category=electronics
+// (including subcategories), ?tag=sale and sorting via ?sort=-price.
+// ?currency=EUR adds each price converted with the exchange rate table.
+func (h *Handler) GetItems(c *gin.Context) {
 	// Log the user making the request using JWT data from context
 	username, _ := c.Request.Context().Value("username").(string)
 	email, _ := c.Request.Context().Value("email").(string)
-	log.Printf("GetItems called by user: %s (%s)", username, email)
+	h.Logger.Printf("GetItems called by user: %s (%s)", username, email)
 
-	ctx := context.Background()
-	items, err := models.Client.Item.
-		Query().
-		All(ctx)
+	ctx := c.Request.Context()
+	if c.Query("include_deleted") == "true" {
+		// Only admins may see soft-deleted items
+		if c.GetString("role") != user.RoleAdmin.String() {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+			return
+		}
+		ctx = schema.SkipSoftDelete(ctx)
+	}
+
+	h.listItems(ctx, c, h.Client.Item.Query())
+}
+
+// GetMyItems retrieves a page of the items owned by the authenticated user.
+// Accepts the same query parameters as GetItems.
+func (h *Handler) GetMyItems(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("GetMyItems called by user: %s (%s)", username, email)
+
+	userID := c.GetInt("userID")
+	h.listItems(c.Request.Context(), c, h.Client.Item.Query().Where(item.OwnerID(userID)))
+}
+
+// listItems writes a filtered, sorted and paginated page of the given query
+func (h *Handler) listItems(ctx context.Context, c *gin.Context, query *ent.ItemQuery) {
+	params, err := parsePageParams(c)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filters, err := parseItemFilters(c.Request.URL.Query())
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	orders := []item.OrderOption{item.ByID()}
+	if v := c.Query("sort"); v != "" {
+		// Cursors are keyed on the ID, so they only work with the default order
+		if params.Cursor != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "cursor cannot be combined with sort"})
+			return
+		}
+		orders, err = parseItemSort(v)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	// Image URLs are part of every listed item
+	query = query.Where(filters...).WithImages()
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve items"})
+		h.Logger.Printf("Error counting items: %v", err)
+		return
+	}
+
+	var items []*ent.Item
+	var hasNext, hasPrev bool
+	if cur := params.Cursor; cur != nil {
+		// Fetch one extra row to find out whether another page exists
+		if cur.Backward {
+			items, err = query.
+				Where(item.IDLT(cur.ID)).
+				Order(item.ByID(sql.OrderDesc())).
+				Limit(params.Limit + 1).
+				All(ctx)
+		} else {
+			items, err = query.
+				Where(item.IDGT(cur.ID)).
+				Order(item.ByID()).
+				Limit(params.Limit + 1).
+				All(ctx)
+		}
+		if err == nil {
+			more := len(items) > params.Limit
+			if more {
+				items = items[:params.Limit]
+			}
+			if cur.Backward {
+				// Rows were read in descending order, flip them back
+				for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+					items[i], items[j] = items[j], items[i]
+				}
+				hasPrev, hasNext = more, true
+			} else {
+				hasPrev, hasNext = true, more
+			}
+		}
+	} else {
+		items, err = query.
+			Order(orders...).
+			Offset(params.Offset).
+			Limit(params.Limit).
+			All(ctx)
+		hasPrev = params.Offset > 0
+		hasNext = params.Offset+len(items) < total
+	}
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve items"})
-		log.Printf("Error retrieving items: %v", err)
+		h.Logger.Printf("Error retrieving items: %v", err)
+		return
+	}
+
+	data, ok := h.convertItems(c, items)
+	if !ok {
 		return
 	}
 
-	c.JSON(http.StatusOK, items)
+	resp := pageResponse{
+		Data:  data,
+		Total: total,
+		Limit: params.Limit,
+	}
+	if params.Cursor == nil {
+		resp.Offset = &params.Offset
+	}
+
+	limit := strconv.Itoa(params.Limit)
+	links := map[string]string{
+		"first": pageURL(c, map[string]string{"limit": limit}, "cursor", "offset"),
+	}
+	if c.Query("sort") != "" {
+		// Custom orderings can only be paged by offset
+		if hasNext {
+			links["next"] = pageURL(c, map[string]string{"limit": limit, "offset": strconv.Itoa(params.Offset + params.Limit)})
+		}
+		if hasPrev {
+			links["prev"] = pageURL(c, map[string]string{"limit": limit, "offset": strconv.Itoa(max(params.Offset-params.Limit, 0))})
+		}
+	} else if len(items) > 0 {
+		if hasNext {
+			resp.NextCursor = encodeCursor(pageCursor{ID: items[len(items)-1].ID})
+			links["next"] = pageURL(c, map[string]string{"limit": limit, "cursor": resp.NextCursor}, "offset")
+		}
+		if hasPrev {
+			resp.PrevCursor = encodeCursor(pageCursor{ID: items[0].ID, Backward: true})
+			links["prev"] = pageURL(c, map[string]string{"limit": limit, "cursor": resp.PrevCursor}, "offset")
+		}
+	}
+	setLinkHeader(c, links)
+
+	c.JSON(http.StatusOK, resp)
 }
 
 // GetItem retrieves an item by its ID
-func GetItem(c *gin.Context) {
+func (h *Handler) GetItem(c *gin.Context) {
 	// Log the user making the request using JWT data from context
 	username, _ := c.Request.Context().Value("username").(string)
 	email, _ := c.Request.Context().Value("email").(string)
-	log.Printf("GetItem called by user: %s (%s)", username, email)
+	h.Logger.Printf("GetItem called by user: %s (%s)", username, email)
 
 	idStr := c.Param("id")
 
@@ -51,11 +196,14 @@ func GetItem(c *gin.Context) {
 
 
 	
-	// Query the item by the int64 ID using models.Client
-	ctx := context.Background()
-	item, err := models.Client.Item.
+	// Query the item by the int64 ID using h.Client
+	ctx := c.Request.Context()
+	item, err := h.Client.Item.
 		Query().
 		Where(item.ID(id)).
+		WithCategories().
+		WithTags().
+		WithImages().
 		Only(ctx)
 
 	if err != nil {
@@ -64,24 +212,32 @@ func GetItem(c *gin.Context) {
 			return
 		}
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve item"})
-		log.Printf("Error retrieving item: %v", err)
+		h.Logger.Printf("Error retrieving item: %v", err)
 		return
 	}
 
-	c.JSON(http.StatusOK, item)
+	data, ok := h.convertItem(c, item)
+	if !ok {
+		return
+	}
+
+	c.Header("ETag", itemETag(item))
+	c.JSON(http.StatusOK, data)
 }
 
 // CreateItem creates a new item
-func CreateItem(c *gin.Context) {
+func (h *Handler) CreateItem(c *gin.Context) {
 	// Log the user making the request using JWT data from context
 	username, _ := c.Request.Context().Value("username").(string)
 	email, _ := c.Request.Context().Value("email").(string)
-	log.Printf("CreateItem called by user: %s (%s)", username, email)
+	h.Logger.Printf("CreateItem called by user: %s (%s)", username, email)
 
 	var newItem struct {
 		Name        string `json:"name" binding:"required"`
 		Price       int    `json:"price"`
+		Currency    string `json:"currency"`
 		Description string `json:"description"`
+		Stock       int    `json:"stock"`
 	}
 
 	if err := c.ShouldBindJSON(&newItem); err != nil {
@@ -94,28 +250,52 @@ func CreateItem(c *gin.Context) {
 		return
 	}
 
-	ctx := context.Background()
-	createdItem, err := models.Client.Item.
-		Create().
-		SetName(newItem.Name).
-		SetPrice(newItem.Price).
-		SetDescription(newItem.Description).
-		Save(ctx)
+	if newItem.Stock < 0 {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Stock cannot be negative"})
+		return
+	}
+
+	if newItem.Currency == "" {
+		newItem.Currency = money.DefaultCurrency
+	}
+	if err := money.ValidateCurrency(newItem.Currency); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The item, its first revision and its audit entry are written together
+	ctx := c.Request.Context()
+	var createdItem *ent.Item
+	err := h.withTx(ctx, func(tx *ent.Tx) error {
+		var err error
+		createdItem, err = tx.Item.
+			Create().
+			SetName(newItem.Name).
+			SetPrice(newItem.Price).
+			SetCurrency(newItem.Currency).
+			SetDescription(newItem.Description).
+			SetStock(newItem.Stock).
+			SetOwnerID(c.GetInt("userID")).
+			Save(ctx)
+		return err
+	})
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to create item"})
-		log.Printf("Error creating item: %v", err)
+		h.Logger.Printf("Error creating item: %v", err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, createdItem)
+	c.Header("ETag", itemETag(createdItem))
+	c.JSON(http.StatusCreated, newItemResponse(createdItem))
 }
 
-// UpdateItem updates an existing item
-func UpdateItem(c *gin.Context) {
+// UpdateItem updates an existing item.
+// The If-Match header must carry the item's current ETag.
+func (h *Handler) UpdateItem(c *gin.Context) {
 	// Log the user making the request using JWT data from context
 	username, _ := c.Request.Context().Value("username").(string)
 	email, _ := c.Request.Context().Value("email").(string)
-	log.Printf("UpdateItem called by user: %s (%s)", username, email)
+	h.Logger.Printf("UpdateItem called by user: %s (%s)", username, email)
 
 	idStr := c.Param("id")
 
@@ -129,7 +309,9 @@ func UpdateItem(c *gin.Context) {
 	var updatedItem struct {
 		Name        string `json:"name" binding:"required"`
 		Price       int    `json:"price"`
+		Currency    string `json:"currency"`
 		Description string `json:"description"`
+		Stock       int    `json:"stock"`
 	}
 
 	if err := c.ShouldBindJSON(&updatedItem); err != nil {
@@ -142,32 +324,61 @@ func UpdateItem(c *gin.Context) {
 		return
 	}
 
-	ctx := context.Background()
-	updated, err := models.Client.Item.
+	if updatedItem.Stock < 0 {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Stock cannot be negative"})
+		return
+	}
+
+	if updatedItem.Currency == "" {
+		updatedItem.Currency = money.DefaultCurrency
+	}
+	if err := money.ValidateCurrency(updatedItem.Currency); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	version, ok := parseIfMatch(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, ok := h.authorizeItemWrite(ctx, c, id); !ok {
+		return
+	}
+
+	// The version check and the write happen in a single conditional UPDATE
+	updated, err := h.Client.Item.
 		UpdateOneID(id).
+		Where(versionPredicates(version)...).
 		SetName(updatedItem.Name).
 		SetPrice(updatedItem.Price).
+		SetCurrency(updatedItem.Currency).
 		SetDescription(updatedItem.Description).
+		SetStock(updatedItem.Stock).
+		AddVersion(1).
 		Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+			h.respondWriteMiss(ctx, c, id)
 			return
 		}
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update item"})
-		log.Printf("Error updating item: %v", err)
+		h.Logger.Printf("Error updating item: %v", err)
 		return
 	}
 
-	c.JSON(http.StatusOK, updated)
+	c.Header("ETag", itemETag(updated))
+	c.JSON(http.StatusOK, newItemResponse(updated))
 }
 
-// DeleteItem deletes an item by ID
-func DeleteItem(c *gin.Context) {
+// DeleteItem soft-deletes an item by ID. It can be restored until it is purged.
+// The If-Match header must carry the item's current ETag.
+func (h *Handler) DeleteItem(c *gin.Context) {
 	// Log the user making the request using JWT data from context
 	username, _ := c.Request.Context().Value("username").(string)
 	email, _ := c.Request.Context().Value("email").(string)
-	log.Printf("DeleteItem called by user: %s (%s)", username, email)
+	h.Logger.Printf("DeleteItem called by user: %s (%s)", username, email)
 
 	idStr := c.Param("id")
 
@@ -178,19 +389,199 @@ func DeleteItem(c *gin.Context) {
 		return
 	}
 
-	ctx := context.Background()
-	err = models.Client.Item.
+	version, ok := parseIfMatch(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, ok := h.authorizeItemWrite(ctx, c, id); !ok {
+		return
+	}
+
+	err = h.Client.Item.
 		DeleteOneID(id).
+		Where(versionPredicates(version)...).
 		Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+			h.respondWriteMiss(ctx, c, id)
 			return
 		}
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete item"})
-		log.Printf("Error deleting item: %v", err)
+		h.Logger.Printf("Error deleting item: %v", err)
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
+
+// authorizeItemWrite checks that the authenticated user owns the item or is an admin.
+// It returns the current item, or writes the error response and returns false
+// when the request must not proceed.
+func (h *Handler) authorizeItemWrite(ctx context.Context, c *gin.Context, id int64) (*ent.Item, bool) {
+	existing, err := h.Client.Item.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+			return nil, false
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve item"})
+		h.Logger.Printf("Error retrieving item: %v", err)
+		return nil, false
+	}
+
+	userID := c.GetInt("userID")
+	if existing.OwnerID == userID {
+		return existing, true
+	}
+
+	// Admins may modify any item
+	if c.GetString("role") != user.RoleAdmin.String() {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "You do not have permission to modify this item"})
+		return nil, false
+	}
+	return existing, true
+}
+
+// AdjustStock atomically increments or decrements an item's stock.
+// A decrement that would take the stock below zero is rejected with 409.
+func (h *Handler) AdjustStock(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("AdjustStock called by user: %s (%s)", username, email)
+
+	idStr := c.Param("id")
+
+	// Convert the string ID to int64
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var adjustment struct {
+		Delta  int    `json:"delta" binding:"required"`
+		Reason string `json:"reason" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&adjustment); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	if adjustment.Delta == 0 || adjustment.Reason == "" {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "A non-zero delta and a reason are required"})
+		return
+	}
+
+	// Check that the user is allowed to modify this item
+	ctx := c.Request.Context()
+	if _, ok := h.authorizeItemWrite(ctx, c, id); !ok {
+		return
+	}
+
+	// The stock guard and the increment run as a single UPDATE, so concurrent
+	// adjustments can never drive the stock negative
+	affected, err := h.Client.Item.
+		Update().
+		Where(item.ID(id), item.DeletedAtIsNil(), item.StockGTE(-adjustment.Delta)).
+		AddStock(adjustment.Delta).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to adjust stock"})
+		h.Logger.Printf("Error adjusting stock: %v", err)
+		return
+	}
+
+	updated, err := h.Client.Item.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve item"})
+		h.Logger.Printf("Error retrieving item: %v", err)
+		return
+	}
+
+	if affected == 0 {
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "Insufficient stock", "stock": updated.Stock})
+		return
+	}
+
+	c.Header("ETag", itemETag(updated))
+	h.Logger.Printf("Stock of item %d adjusted by %d by user %s: %s", id, adjustment.Delta, username, adjustment.Reason)
+
+	c.JSON(http.StatusOK, newItemResponse(updated))
+}
+
+// versionPredicates restricts a write to the version sent in If-Match.
+// A nil version (If-Match: *) matches any version.
+func versionPredicates(version *int) []predicate.Item {
+	if version == nil {
+		return nil
+	}
+	return []predicate.Item{item.Version(*version)}
+}
+
+// respondWriteMiss answers a conditional write that matched no row: either the
+// item is gone or its version moved since the client read it
+func (h *Handler) respondWriteMiss(ctx context.Context, c *gin.Context, id int64) {
+	exists, err := h.Client.Item.Query().Where(item.ID(id)).Exist(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve item"})
+		h.Logger.Printf("Error retrieving item: %v", err)
+		return
+	}
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+		return
+	}
+	c.AbortWithStatusJSON(http.StatusPreconditionFailed, gin.H{"error": "Item has been modified, fetch it again and retry"})
+}
+
+// RestoreItem brings back a soft-deleted item
+func (h *Handler) RestoreItem(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("RestoreItem called by user: %s (%s)", username, email)
+
+	idStr := c.Param("id")
+
+	// Convert the string ID to int64
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	// Soft-deleted rows are only visible with the skip context
+	ctx := schema.SkipSoftDelete(c.Request.Context())
+	restored, err := h.Client.Item.
+		UpdateOneID(id).
+		Where(item.DeletedAtNotNil()).
+		ClearDeletedAt().
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			exists, err := h.Client.Item.Query().Where(item.ID(id)).Exist(ctx)
+			if err == nil && exists {
+				c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "Item is not deleted"})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore item"})
+		h.Logger.Printf("Error restoring item: %v", err)
+		return
+	}
+
+	c.Header("ETag", itemETag(restored))
+	c.JSON(http.StatusOK, newItemResponse(restored))
+}
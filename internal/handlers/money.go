@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"gin-crud/ent"
+	"gin-crud/internal/money"
+
+	"github.com/gin-gonic/gin"
+)
+
+// itemResponse is an item as the API returns it: its fields plus the price
+// formatted in the major unit of its currency, e.g. "price": 1999,
+// "price_formatted": "19.99"
+type itemResponse struct {
+	*ent.Item
+	PriceFormatted string `json:"price_formatted"`
+}
+
+// newItemResponse wraps an item for a response
+func newItemResponse(it *ent.Item) *itemResponse {
+	return &itemResponse{Item: it, PriceFormatted: money.Format(int64(it.Price), it.Currency)}
+}
+
+// newItemResponses wraps a list of items for a response
+func newItemResponses(items []*ent.Item) []*itemResponse {
+	out := make([]*itemResponse, len(items))
+	for i, it := range items {
+		out[i] = newItemResponse(it)
+	}
+	return out
+}
+
+// convertedItem is an item with its price also shown in the currency the client asked for
+type convertedItem struct {
+	*itemResponse
+	ConvertedPrice money.Money `json:"converted_price"`
+}
+
+// convertItems converts item prices to the currency in ?currency=. Without the
+// parameter the items are returned as they are. When a price cannot be
+// converted the error response is written and ok is false.
+func (h *Handler) convertItems(c *gin.Context, items []*ent.Item) (any, bool) {
+	target := c.Query("currency")
+	if target == "" {
+		return newItemResponses(items), true
+	}
+	if err := money.ValidateCurrency(target); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, false
+	}
+
+	converted := make([]convertedItem, len(items))
+	for i, it := range items {
+		amount, err := h.Rates.Convert(int64(it.Price), it.Currency, target)
+		if err != nil {
+			if errors.Is(err, money.ErrNoRate) {
+				c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+				return nil, false
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to convert prices"})
+			return nil, false
+		}
+		converted[i] = convertedItem{itemResponse: newItemResponse(it), ConvertedPrice: money.New(amount, target)}
+	}
+	return converted, true
+}
+
+// convertItem is convertItems for a single item
+func (h *Handler) convertItem(c *gin.Context, it *ent.Item) (any, bool) {
+	data, ok := h.convertItems(c, []*ent.Item{it})
+	if converted, isConverted := data.([]convertedItem); ok && isConverted {
+		return converted[0], true
+	}
+	return newItemResponse(it), ok
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetItemFormatsPrice(t *testing.T) {
+	h := newTestHandler(t)
+	it := h.Client.Item.Create().SetName("Keyboard").SetPrice(1999).SetCurrency("USD").SaveX(t.Context())
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/items/%d", it.ID), nil)
+	w := serve("/items/:id", req, h.GetItem)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["name"] != "Keyboard" || got["price"] != float64(1999) || got["price_formatted"] != "19.99" {
+		t.Errorf("got %s, want name, price 1999 and price_formatted 19.99", w.Body)
+	}
+}
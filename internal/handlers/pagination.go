@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// defaultPageLimit is used when the client does not send a limit
+	defaultPageLimit = 20
+	// maxPageLimit caps the number of rows returned in a single page
+	maxPageLimit = 100
+)
+
+// pageCursor is the decoded form of the opaque cursor handed out to clients.
+// It is keyed on the item ID; Backward marks a cursor that pages towards lower IDs.
+type pageCursor struct {
+	ID       int64 `json:"id"`
+	Backward bool  `json:"b,omitempty"`
+}
+
+// pageParams holds the pagination options parsed from the query string
+type pageParams struct {
+	Limit  int
+	Offset int
+	Cursor *pageCursor
+}
+
+// pageResponse is the envelope returned by paginated list endpoints
+type pageResponse struct {
+	Data       any    `json:"data"`
+	Total      int    `json:"total"`
+	Limit      int    `json:"limit"`
+	Offset     *int   `json:"offset,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
+
+// encodeCursor turns a cursor into an opaque, URL-safe string
+func encodeCursor(cur pageCursor) string {
+	raw, _ := json.Marshal(cur)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeCursor parses a cursor previously produced by encodeCursor
+func decodeCursor(s string) (*pageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	var cur pageCursor
+	if err := json.Unmarshal(raw, &cur); err != nil || cur.ID <= 0 {
+		return nil, errors.New("invalid cursor")
+	}
+	return &cur, nil
+}
+
+// parsePageParams reads limit, offset and cursor from the query string.
+// offset and cursor are mutually exclusive.
+func parsePageParams(c *gin.Context) (pageParams, error) {
+	params := pageParams{Limit: defaultPageLimit}
+
+	if v := c.Query("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			return params, errors.New("limit must be a positive integer")
+		}
+		if limit > maxPageLimit {
+			limit = maxPageLimit
+		}
+		params.Limit = limit
+	}
+
+	if v := c.Query("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil || offset < 0 {
+			return params, errors.New("offset must be a non-negative integer")
+		}
+		params.Offset = offset
+	}
+
+	if v := c.Query("cursor"); v != "" {
+		if c.Query("offset") != "" {
+			return params, errors.New("offset and cursor cannot be combined")
+		}
+		cur, err := decodeCursor(v)
+		if err != nil {
+			return params, err
+		}
+		params.Cursor = cur
+	}
+
+	return params, nil
+}
+
+// pageURL returns the current request URL with the given query parameters
+// replaced and the ones listed in del removed
+func pageURL(c *gin.Context, set map[string]string, del ...string) string {
+	u := *c.Request.URL
+	q := u.Query()
+	for _, key := range del {
+		q.Del(key)
+	}
+	for key, value := range set {
+		q.Set(key, value)
+	}
+	u.RawQuery = q.Encode()
+	return u.RequestURI()
+}
+
+// setLinkHeader writes an RFC 8288 Link header for the given rel => URL pairs
+func setLinkHeader(c *gin.Context, links map[string]string) {
+	var parts []string
+	for _, rel := range []string{"first", "prev", "next", "last"} {
+		if target, ok := links[rel]; ok {
+			parts = append(parts, fmt.Sprintf("<%s>; rel=\"%s\"", target, rel))
+		}
+	}
+	if len(parts) > 0 {
+		c.Header("Link", strings.Join(parts, ", "))
+	}
+}
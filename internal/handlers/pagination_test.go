@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+// listedPage is a decoded GetItems response
+type listedPage struct {
+	IDs        []int64
+	Total      int
+	Offset     *int
+	NextCursor string
+	PrevCursor string
+	// Links maps each rel of the Link header to its URL
+	Links map[string]string
+}
+
+var linkPart = regexp.MustCompile(`<([^>]*)>; rel="([a-z]+)"`)
+
+// listItemsPage runs GetItems with the given query string
+func listItemsPage(t *testing.T, h *Handler, query string) (listedPage, *httptest.ResponseRecorder) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/items?"+query, nil)
+	w := serve("/items", req, h.GetItems)
+
+	var page listedPage
+	if w.Code != http.StatusOK {
+		return page, w
+	}
+	var body struct {
+		Data []struct {
+			ID int64 `json:"id"`
+		} `json:"data"`
+		Total      int    `json:"total"`
+		Offset     *int   `json:"offset"`
+		NextCursor string `json:"next_cursor"`
+		PrevCursor string `json:"prev_cursor"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	page = listedPage{Total: body.Total, Offset: body.Offset, NextCursor: body.NextCursor, PrevCursor: body.PrevCursor, Links: map[string]string{}}
+	for _, d := range body.Data {
+		page.IDs = append(page.IDs, d.ID)
+	}
+	for _, m := range linkPart.FindAllStringSubmatch(w.Header().Get("Link"), -1) {
+		page.Links[m[2]] = m[1]
+	}
+	return page, w
+}
+
+// createTestItems stores items with the given prices and returns their IDs
+func createTestItems(t *testing.T, h *Handler, prices ...int) []int64 {
+	t.Helper()
+	var ids []int64
+	for i, price := range prices {
+		it := h.Client.Item.Create().SetName(fmt.Sprintf("Item %d", i+1)).SetPrice(price).SaveX(t.Context())
+		ids = append(ids, it.ID)
+	}
+	return ids
+}
+
+func TestDecodeCursor(t *testing.T) {
+	valid := encodeCursor(pageCursor{ID: 42, Backward: true})
+	if cur, err := decodeCursor(valid); err != nil || *cur != (pageCursor{ID: 42, Backward: true}) {
+		t.Fatalf("round trip = %+v, %v", cur, err)
+	}
+
+	for _, tc := range []struct {
+		name, cursor string
+	}{
+		{"not base64", "!!!"},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"id":1}`))},
+		{"not JSON", base64.RawURLEncoding.EncodeToString([]byte("id=1"))},
+		{"zero ID", base64.RawURLEncoding.EncodeToString([]byte(`{"id":0}`))},
+		{"negative ID", base64.RawURLEncoding.EncodeToString([]byte(`{"id":-5}`))},
+		{"ID of the wrong type", base64.RawURLEncoding.EncodeToString([]byte(`{"id":"7"}`))},
+		{"truncated", valid[:len(valid)-3]},
+	} {
+		if cur, err := decodeCursor(tc.cursor); err == nil {
+			t.Errorf("%s: decoded %+v, want an error", tc.name, cur)
+		}
+	}
+}
+
+func TestGetItemsRejectsPageParams(t *testing.T) {
+	h := newTestHandler(t)
+	cursor := encodeCursor(pageCursor{ID: 1})
+
+	for _, query := range []string{
+		"limit=0",
+		"limit=-1",
+		"limit=ten",
+		"offset=-1",
+		"offset=two",
+		"cursor=garbage",
+		"cursor=" + cursor + "&offset=0",
+		"cursor=" + cursor + "&sort=price",
+		"sort=owner_id",
+		"sort=price,-price",
+	} {
+		if _, w := listItemsPage(t, h, query); w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d", query, w.Code, http.StatusBadRequest)
+		}
+	}
+
+	_, w := listItemsPage(t, h, "limit=1000")
+	if w.Code != http.StatusOK {
+		t.Fatalf("limit=1000: status = %d", w.Code)
+	}
+	var body struct{ Limit int }
+	json.Unmarshal(w.Body.Bytes(), &body)
+	if body.Limit != maxPageLimit {
+		t.Errorf("limit=1000: limit = %d, want it capped at %d", body.Limit, maxPageLimit)
+	}
+}
+
+func TestGetItemsCursorPaging(t *testing.T) {
+	h := newTestHandler(t)
+	ids := createTestItems(t, h, 100, 200, 300, 400, 500)
+
+	// Forward from the first page to the last
+	first, _ := listItemsPage(t, h, "limit=2")
+	if !reflect.DeepEqual(first.IDs, ids[:2]) || first.Total != 5 {
+		t.Fatalf("first page = %v of %d", first.IDs, first.Total)
+	}
+	if _, ok := first.Links["prev"]; ok || first.PrevCursor != "" {
+		t.Errorf("first page links to a previous page: %v", first.Links)
+	}
+	if first.Links["first"] == "" || first.Links["next"] == "" {
+		t.Errorf("first page links = %v, want first and next", first.Links)
+	}
+
+	second, _ := listItemsPage(t, h, "limit=2&cursor="+first.NextCursor)
+	if !reflect.DeepEqual(second.IDs, ids[2:4]) || second.Offset != nil {
+		t.Fatalf("second page = %v, offset %v", second.IDs, second.Offset)
+	}
+
+	// The Link header carries the same cursor as the body
+	next, err := url.Parse(second.Links["next"])
+	if err != nil || next.Query().Get("cursor") != second.NextCursor || next.Query().Get("limit") != "2" {
+		t.Errorf("next link = %q, want the next cursor and limit", second.Links["next"])
+	}
+
+	last, _ := listItemsPage(t, h, "limit=2&cursor="+second.NextCursor)
+	if !reflect.DeepEqual(last.IDs, ids[4:]) {
+		t.Fatalf("last page = %v", last.IDs)
+	}
+	if _, ok := last.Links["next"]; ok || last.NextCursor != "" {
+		t.Errorf("last page links to a next page: %v", last.Links)
+	}
+
+	// And back again
+	back, _ := listItemsPage(t, h, "limit=2&cursor="+last.PrevCursor)
+	if !reflect.DeepEqual(back.IDs, ids[2:4]) {
+		t.Fatalf("page before the last = %v", back.IDs)
+	}
+	front, _ := listItemsPage(t, h, "limit=2&cursor="+back.PrevCursor)
+	if !reflect.DeepEqual(front.IDs, ids[:2]) {
+		t.Fatalf("first page paging backward = %v", front.IDs)
+	}
+	if _, ok := front.Links["prev"]; ok {
+		t.Errorf("first page reached backward links to a previous page: %v", front.Links)
+	}
+
+	// A cursor past either end yields an empty page without cursors
+	for _, cur := range []pageCursor{{ID: ids[4]}, {ID: ids[0], Backward: true}} {
+		page, _ := listItemsPage(t, h, "limit=2&cursor="+encodeCursor(cur))
+		if len(page.IDs) != 0 || page.NextCursor != "" || page.PrevCursor != "" {
+			t.Errorf("cursor %+v: page = %+v", cur, page)
+		}
+	}
+}
+
+func TestGetItemsSortTies(t *testing.T) {
+	h := newTestHandler(t)
+	ids := createTestItems(t, h, 300, 100, 300, 100, 200)
+
+	for _, tc := range []struct {
+		sort string
+		want []int64
+	}{
+		// Equal prices fall back to the ID, so every row shows up exactly once
+		{"price", []int64{ids[1], ids[3], ids[4], ids[0], ids[2]}},
+		{"-price", []int64{ids[0], ids[2], ids[4], ids[1], ids[3]}},
+		{"-price,-id", []int64{ids[2], ids[0], ids[4], ids[3], ids[1]}},
+	} {
+		var got []int64
+		query := "limit=2&sort=" + tc.sort
+		for pages := 0; query != ""; pages++ {
+			if pages > len(ids) {
+				t.Fatalf("sort=%s: paging does not end", tc.sort)
+			}
+			page, w := listItemsPage(t, h, query)
+			if w.Code != http.StatusOK {
+				t.Fatalf("sort=%s: status = %d, body %s", tc.sort, w.Code, w.Body)
+			}
+			if page.NextCursor != "" {
+				t.Errorf("sort=%s: sorted pages must not hand out cursors", tc.sort)
+			}
+			got = append(got, page.IDs...)
+
+			query = ""
+			if next := page.Links["next"]; next != "" {
+				u, _ := url.Parse(next)
+				query = u.RawQuery
+			}
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("sort=%s: got %v, want %v", tc.sort, got, tc.want)
+		}
+	}
+
+	// The previous link of a middle offset page goes back one page
+	page, _ := listItemsPage(t, h, "limit=2&offset=2&sort=price")
+	prev, _ := url.Parse(page.Links["prev"])
+	if prev.Query().Get("offset") != "0" || prev.Query().Get("sort") != "price" {
+		t.Errorf("prev link = %q", page.Links["prev"])
+	}
+}
@@ -0,0 +1,306 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gin-crud/ent"
+	"gin-crud/internal/money"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	mergePatchContentType = "application/merge-patch+json"
+	jsonPatchContentType  = "application/json-patch+json"
+)
+
+// errPatchTestFailed is returned when a JSON Patch "test" operation does not match
+var errPatchTestFailed = errors.New("patch test operation failed")
+
+// itemPatch holds the fields a patch document touches. Nil fields are left as is.
+type itemPatch struct {
+	Name             *string
+	Price            *int
+	Currency         *string
+	Description      *string
+	ClearDescription bool
+	Stock            *int
+}
+
+// set applies a single JSON value to the named field
+func (p *itemPatch) set(field string, raw json.RawMessage) error {
+	isNull := bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+
+	switch field {
+	case "name":
+		if isNull {
+			return errors.New("name cannot be null")
+		}
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return errors.New("name must be a string")
+		}
+		p.Name = &v
+	case "price":
+		if isNull {
+			return errors.New("price cannot be null")
+		}
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return errors.New("price must be an integer")
+		}
+		p.Price = &v
+	case "currency":
+		if isNull {
+			return errors.New("currency cannot be null")
+		}
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return errors.New("currency must be a string")
+		}
+		p.Currency = &v
+	case "description":
+		// null clears the optional description
+		if isNull {
+			p.Description, p.ClearDescription = nil, true
+			return nil
+		}
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return errors.New("description must be a string")
+		}
+		p.Description, p.ClearDescription = &v, false
+	case "stock":
+		if isNull {
+			return errors.New("stock cannot be null")
+		}
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return errors.New("stock must be an integer")
+		}
+		p.Stock = &v
+	default:
+		return fmt.Errorf("field %q cannot be patched", field)
+	}
+	return nil
+}
+
+// validate checks the patched values against the same rules as create/update
+func (p *itemPatch) validate() error {
+	if p.Name != nil && *p.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if p.Stock != nil && *p.Stock < 0 {
+		return errors.New("stock cannot be negative")
+	}
+	if p.Currency != nil {
+		if err := money.ValidateCurrency(*p.Currency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply sets only the fields present in the patch on the update builder
+func (p *itemPatch) apply(update *ent.ItemUpdateOne) *ent.ItemUpdateOne {
+	if p.Name != nil {
+		update.SetName(*p.Name)
+	}
+	if p.Price != nil {
+		update.SetPrice(*p.Price)
+	}
+	if p.Currency != nil {
+		update.SetCurrency(*p.Currency)
+	}
+	if p.Description != nil {
+		update.SetDescription(*p.Description)
+	}
+	if p.ClearDescription {
+		update.ClearDescription()
+	}
+	if p.Stock != nil {
+		update.SetStock(*p.Stock)
+	}
+	return update
+}
+
+// decodeMergePatch parses an RFC 7396 JSON Merge Patch document
+func decodeMergePatch(body []byte) (*itemPatch, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(body, &doc); err != nil || doc == nil {
+		return nil, errors.New("merge patch must be a JSON object")
+	}
+	return mergePatchFromObject(doc)
+}
+
+// mergePatchFromObject builds a patch from an already decoded merge patch object
+func mergePatchFromObject(doc map[string]json.RawMessage) (*itemPatch, error) {
+	p := &itemPatch{}
+	for field, raw := range doc {
+		if err := p.set(field, raw); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// decodeJSONPatch parses an RFC 6902 JSON Patch document against the current item.
+// Only add, replace, remove and test on top-level fields are supported.
+func decodeJSONPatch(body []byte, current *ent.Item) (*itemPatch, error) {
+	var ops []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(body, &ops); err != nil {
+		return nil, errors.New("JSON patch must be an array of operations")
+	}
+
+	// state tracks the document as the operations are applied, for "test"
+	state := map[string]any{
+		"name":        current.Name,
+		"price":       current.Price,
+		"currency":    current.Currency,
+		"description": current.Description,
+		"stock":       current.Stock,
+	}
+
+	p := &itemPatch{}
+	for i, op := range ops {
+		field, ok := strings.CutPrefix(op.Path, "/")
+		if !ok || strings.Contains(field, "/") {
+			return nil, fmt.Errorf("operation %d: unsupported path %q", i, op.Path)
+		}
+		if _, ok := state[field]; !ok {
+			return nil, fmt.Errorf("operation %d: field %q cannot be patched", i, field)
+		}
+
+		switch op.Op {
+		case "add", "replace":
+			if op.Value == nil {
+				return nil, fmt.Errorf("operation %d: value is required", i)
+			}
+			if err := p.set(field, op.Value); err != nil {
+				return nil, fmt.Errorf("operation %d: %v", i, err)
+			}
+			var v any
+			json.Unmarshal(op.Value, &v)
+			state[field] = v
+		case "remove":
+			if field != "description" {
+				return nil, fmt.Errorf("operation %d: field %q cannot be removed", i, field)
+			}
+			p.set(field, json.RawMessage("null"))
+			state[field] = nil
+		case "test":
+			var want any
+			if err := json.Unmarshal(op.Value, &want); err != nil {
+				return nil, fmt.Errorf("operation %d: invalid value", i)
+			}
+			// Round-trip the current value so numbers compare as float64
+			var got any
+			raw, _ := json.Marshal(state[field])
+			json.Unmarshal(raw, &got)
+			if !reflect.DeepEqual(got, want) {
+				return nil, fmt.Errorf("operation %d: %w", i, errPatchTestFailed)
+			}
+		default:
+			return nil, fmt.Errorf("operation %d: unsupported op %q", i, op.Op)
+		}
+	}
+	return p, nil
+}
+
+// PatchItem partially updates an item.
+// Accepts application/merge-patch+json (or application/json) and
+// application/json-patch+json bodies. The If-Match header must carry the
+// item's current ETag.
+func (h *Handler) PatchItem(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("PatchItem called by user: %s (%s)", username, email)
+
+	idStr := c.Param("id")
+
+	// Convert the string ID to int64
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	contentType := c.ContentType()
+	if contentType != mergePatchContentType && contentType != jsonPatchContentType && contentType != "application/json" {
+		c.AbortWithStatusJSON(http.StatusUnsupportedMediaType, gin.H{"error": "Unsupported patch format"})
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	version, ok := parseIfMatch(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	current, ok := h.authorizeItemWrite(ctx, c, id)
+	if !ok {
+		return
+	}
+
+	var patch *itemPatch
+	if contentType == jsonPatchContentType {
+		patch, err = decodeJSONPatch(body, current)
+	} else {
+		patch, err = decodeMergePatch(body)
+	}
+	if err == nil {
+		err = patch.validate()
+	}
+	if err != nil {
+		if errors.Is(err, errPatchTestFailed) {
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The patch was built against current, and JSON Patch "test" ops checked
+	// it, so even with If-Match: * the write only applies to that version
+	if version == nil {
+		version = &current.Version
+	}
+
+	update := h.Client.Item.
+		UpdateOneID(id).
+		Where(versionPredicates(version)...).
+		AddVersion(1)
+
+	updated, err := patch.apply(update).Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			h.respondWriteMiss(ctx, c, id)
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update item"})
+		h.Logger.Printf("Error patching item: %v", err)
+		return
+	}
+
+	c.Header("ETag", itemETag(updated))
+	c.JSON(http.StatusOK, newItemResponse(updated))
+}
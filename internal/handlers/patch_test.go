@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gin-crud/ent"
+)
+
+func patchRequest(h *Handler, id int64, contentType, ifMatch, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/items/%d", id), strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("If-Match", ifMatch)
+	return serve("/items/:id", req, asAdmin, h.PatchItem)
+}
+
+func TestPatchItemMergePatch(t *testing.T) {
+	for _, tc := range []struct {
+		name, body string
+		want       int
+		check      func(*ent.Item) bool
+	}{
+		{"null clears description", `{"description": null}`, http.StatusOK,
+			func(it *ent.Item) bool { return it.Description == "" && it.Name == "Keyboard" }},
+		{"absent fields are kept", `{"stock": 7}`, http.StatusOK,
+			func(it *ent.Item) bool { return it.Stock == 7 && it.Description == "Clicky" && it.Price == 100 }},
+		{"null name", `{"name": null}`, http.StatusUnprocessableEntity, nil},
+		{"null price", `{"price": null}`, http.StatusUnprocessableEntity, nil},
+		{"wrong type", `{"stock": "many"}`, http.StatusUnprocessableEntity, nil},
+		{"unknown field", `{"owner_id": 2}`, http.StatusUnprocessableEntity, nil},
+		{"not an object", `[]`, http.StatusUnprocessableEntity, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newTestHandler(t)
+			it := createTestItem(t, h, "Keyboard", "Clicky")
+
+			w := patchRequest(h, it.ID, mergePatchContentType, itemETag(it), tc.body)
+			if w.Code != tc.want {
+				t.Fatalf("status = %d, want %d, body %s", w.Code, tc.want, w.Body)
+			}
+			if tc.check != nil && !tc.check(h.Client.Item.GetX(t.Context(), it.ID)) {
+				t.Errorf("unexpected item after patch: %s", w.Body)
+			}
+		})
+	}
+}
+
+func TestPatchItemJSONPatch(t *testing.T) {
+	for _, tc := range []struct {
+		name, body string
+		want       int
+	}{
+		{"test then replace", `[{"op": "test", "path": "/stock", "value": 0}, {"op": "replace", "path": "/stock", "value": 3}]`, http.StatusOK},
+		{"failed test", `[{"op": "test", "path": "/name", "value": "Mouse"}, {"op": "replace", "path": "/stock", "value": 3}]`, http.StatusConflict},
+		{"test sees earlier ops", `[{"op": "replace", "path": "/stock", "value": 3}, {"op": "test", "path": "/stock", "value": 0}]`, http.StatusConflict},
+		{"remove description", `[{"op": "remove", "path": "/description"}]`, http.StatusOK},
+		{"remove required field", `[{"op": "remove", "path": "/name"}]`, http.StatusUnprocessableEntity},
+		{"move", `[{"op": "move", "from": "/name", "path": "/description"}]`, http.StatusUnprocessableEntity},
+		{"copy", `[{"op": "copy", "from": "/name", "path": "/description"}]`, http.StatusUnprocessableEntity},
+		{"nested path", `[{"op": "replace", "path": "/edges/tags", "value": []}]`, http.StatusUnprocessableEntity},
+		{"unknown path", `[{"op": "replace", "path": "/owner_id", "value": 2}]`, http.StatusUnprocessableEntity},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newTestHandler(t)
+			it := createTestItem(t, h, "Keyboard", "Clicky")
+
+			w := patchRequest(h, it.ID, jsonPatchContentType, itemETag(it), tc.body)
+			if w.Code != tc.want {
+				t.Fatalf("status = %d, want %d, body %s", w.Code, tc.want, w.Body)
+			}
+			if tc.want != http.StatusOK {
+				if got := h.Client.Item.GetX(t.Context(), it.ID); got.Version != it.Version {
+					t.Errorf("rejected patch wrote version %d", got.Version)
+				}
+			}
+		})
+	}
+}
+
+func TestPatchItemWildcardRace(t *testing.T) {
+	h := newTestHandler(t)
+	it := h.Client.Item.Create().SetName("Keyboard").SetPrice(100).SetStock(5).SaveX(t.Context())
+
+	// Another writer empties the stock right after the handler reads the item
+	raced := false
+	h.Client.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			v, err := next.Query(ctx, q)
+			if !raced {
+				raced = true
+				h.Client.Item.UpdateOneID(it.ID).SetStock(0).AddVersion(1).ExecX(ctx)
+			}
+			return v, err
+		})
+	}))
+
+	body := `[{"op": "test", "path": "/stock", "value": 5}, {"op": "replace", "path": "/stock", "value": 4}]`
+	w := patchRequest(h, it.ID, jsonPatchContentType, "*", body)
+	if w.Code != http.StatusPreconditionFailed {
+		t.Errorf("status = %d, want %d, body %s", w.Code, http.StatusPreconditionFailed, w.Body)
+	}
+	if got := h.Client.Item.GetX(t.Context(), it.ID); got.Stock != 0 {
+		t.Errorf("stock = %d, want the concurrent write's 0", got.Stock)
+	}
+}
@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gin-crud/ent"
+	"gin-crud/ent/item"
+	"gin-crud/ent/itemrevision"
+	"gin-crud/ent/pricechange"
+	"gin-crud/internal/money"
+
+	"github.com/gin-gonic/gin"
+)
+
+// pricePoint is one step of an item's price history
+type pricePoint struct {
+	Price money.Money `json:"price"`
+	// Since is when the item got this price
+	Since    time.Time `json:"since"`
+	Revision int       `json:"revision"`
+}
+
+// SchedulePriceChange schedules a price for an item, e.g. a weekend sale.
+// Body: {"price": 1499, "effective_from": "2025-06-06T00:00:00Z", "effective_to": "2025-06-09T00:00:00Z"}.
+// Without effective_to the new price is permanent. The window may not start in
+// the past, and windows of one item may not overlap.
+func (h *Handler) SchedulePriceChange(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("SchedulePriceChange called by user: %s (%s)", username, email)
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input struct {
+		Price         *int       `json:"price" binding:"required"`
+		EffectiveFrom time.Time  `json:"effective_from" binding:"required"`
+		EffectiveTo   *time.Time `json:"effective_to"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+	if *input.Price < 0 {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": "price must not be negative"})
+		return
+	}
+	if input.EffectiveFrom.Before(time.Now()) {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": "effective_from must not be in the past"})
+		return
+	}
+	if input.EffectiveTo != nil && !input.EffectiveTo.After(input.EffectiveFrom) {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": "effective_to must be after effective_from"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, ok := h.authorizeItemWrite(ctx, c, id); !ok {
+		return
+	}
+
+	var conflict, created *ent.PriceChange
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		// Concurrent requests for the item check for overlaps and insert one at a time
+		if err := lockItem(ctx, tx, id); err != nil {
+			return err
+		}
+
+		// Two windows overlap when each starts before the other ends; a missing end is open-ended
+		query := tx.PriceChange.
+			Query().
+			Where(
+				pricechange.ItemID(id),
+				pricechange.StatusIn(pricechange.StatusScheduled, pricechange.StatusActive),
+				pricechange.Or(pricechange.EffectiveToIsNil(), pricechange.EffectiveToGT(input.EffectiveFrom)),
+			)
+		if input.EffectiveTo != nil {
+			query.Where(pricechange.EffectiveFromLT(*input.EffectiveTo))
+		}
+		var err error
+		conflict, err = query.First(ctx)
+		if err == nil || !ent.IsNotFound(err) {
+			return err
+		}
+
+		create := tx.PriceChange.
+			Create().
+			SetItemID(id).
+			SetPrice(*input.Price).
+			SetEffectiveFrom(input.EffectiveFrom).
+			SetNillableEffectiveTo(input.EffectiveTo)
+		if userID := c.GetInt("userID"); userID != 0 {
+			create.SetCreatedBy(userID)
+		}
+		created, err = create.Save(ctx)
+		return err
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to schedule price change"})
+		h.Logger.Printf("Error scheduling price change: %v", err)
+		return
+	}
+	if conflict != nil {
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "The window overlaps another price change", "conflict": conflict})
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+// lockItem locks the item row until tx ends
+func lockItem(ctx context.Context, tx *ent.Tx, id int64) error {
+	_, err := tx.Item.
+		Query().
+		Where(item.ID(id)).
+		Select(item.FieldID).
+		Modify(forUpdate).
+		Int(ctx)
+	return err
+}
+
+// GetItemPrices returns the price history of an item, built from its
+// revisions, together with its scheduled and past price changes
+func (h *Handler) GetItemPrices(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("GetItemPrices called by user: %s (%s)", username, email)
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	it, err := h.Client.Item.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve item"})
+		h.Logger.Printf("Error retrieving item: %v", err)
+		return
+	}
+
+	revisions, err := h.Client.ItemRevision.
+		Query().
+		Where(itemrevision.ItemID(id)).
+		Order(itemrevision.ByRevision()).
+		Select(itemrevision.FieldRevision, itemrevision.FieldPrice, itemrevision.FieldCurrency, itemrevision.FieldCreatedAt).
+		All(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve price history"})
+		h.Logger.Printf("Error retrieving revisions: %v", err)
+		return
+	}
+
+	// Only revisions that changed the price are points in the history
+	history := []pricePoint{}
+	for _, rev := range revisions {
+		if n := len(history); n > 0 && history[n-1].Price.Amount == int64(rev.Price) && history[n-1].Price.Currency == rev.Currency {
+			continue
+		}
+		history = append(history, pricePoint{
+			Price:    money.New(int64(rev.Price), rev.Currency),
+			Since:    rev.CreatedAt,
+			Revision: rev.Revision,
+		})
+	}
+
+	changes, err := it.QueryPriceChanges().Order(pricechange.ByEffectiveFrom()).All(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve price changes"})
+		h.Logger.Printf("Error retrieving price changes: %v", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"current": money.New(int64(it.Price), it.Currency),
+		"history": history,
+		"changes": changes,
+	})
+}
+
+// CancelPriceChange cancels a price change that has not started yet
+func (h *Handler) CancelPriceChange(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("CancelPriceChange called by user: %s (%s)", username, email)
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+	changeID, err := strconv.Atoi(c.Param("changeID"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid price change ID format"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, ok := h.authorizeItemWrite(ctx, c, id); !ok {
+		return
+	}
+
+	cancelled, err := h.Client.PriceChange.
+		Update().
+		Where(
+			pricechange.ID(changeID),
+			pricechange.ItemID(id),
+			pricechange.StatusEQ(pricechange.StatusScheduled),
+		).
+		SetStatus(pricechange.StatusCancelled).
+		Save(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to cancel price change"})
+		h.Logger.Printf("Error cancelling price change: %v", err)
+		return
+	}
+	if cancelled == 0 {
+		exists, err := h.Client.PriceChange.Query().Where(pricechange.ID(changeID), pricechange.ItemID(id)).Exist(ctx)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to cancel price change"})
+			h.Logger.Printf("Error retrieving price change: %v", err)
+			return
+		}
+		if !exists {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Price change not found"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "Only scheduled price changes can be cancelled"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSchedulePriceChange(t *testing.T) {
+	h := newTestHandler(t)
+	it := createTestItem(t, h, "Keyboard", "")
+	at := func(d time.Duration) string { return time.Now().Add(d).UTC().Format(time.RFC3339) }
+
+	for _, tc := range []struct {
+		name, body string
+		want       int
+	}{
+		{"negative price", fmt.Sprintf(`{"price": -1, "effective_from": %q}`, at(time.Hour)), http.StatusUnprocessableEntity},
+		{"past start without end", fmt.Sprintf(`{"price": 50, "effective_from": %q}`, at(-time.Hour)), http.StatusUnprocessableEntity},
+		{"end before start", fmt.Sprintf(`{"price": 50, "effective_from": %q, "effective_to": %q}`, at(2*time.Hour), at(time.Hour)), http.StatusUnprocessableEntity},
+		{"sale", fmt.Sprintf(`{"price": 50, "effective_from": %q, "effective_to": %q}`, at(time.Hour), at(3*time.Hour)), http.StatusCreated},
+		{"overlapping sale", fmt.Sprintf(`{"price": 60, "effective_from": %q, "effective_to": %q}`, at(2*time.Hour), at(4*time.Hour)), http.StatusConflict},
+		{"later permanent change", fmt.Sprintf(`{"price": 80, "effective_from": %q}`, at(3*time.Hour)), http.StatusCreated},
+	} {
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/items/%d/prices", it.ID), strings.NewReader(tc.body))
+		req.Header.Set("Content-Type", "application/json")
+		w := serve("/items/:id/prices", req, asAdmin, h.SchedulePriceChange)
+		if w.Code != tc.want {
+			t.Errorf("%s: status = %d, want %d, body %s", tc.name, w.Code, tc.want, w.Body)
+		}
+	}
+
+	if n := h.Client.PriceChange.Query().CountX(t.Context()); n != 2 {
+		t.Errorf("got %d price changes, want 2", n)
+	}
+}
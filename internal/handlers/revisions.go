@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"gin-crud/ent"
+	"gin-crud/ent/item"
+	"gin-crud/ent/itemrevision"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+)
+
+// GetItemRevisions lists the stored revisions of an item, newest first
+func (h *Handler) GetItemRevisions(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("GetItemRevisions called by user: %s (%s)", username, email)
+
+	idStr := c.Param("id")
+
+	// Convert the string ID to int64
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	params, err := parsePageParams(c)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if params.Cursor != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "cursor pagination is not supported for revisions"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	exists, err := h.Client.Item.Query().Where(item.ID(id)).Exist(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve item"})
+		h.Logger.Printf("Error retrieving item: %v", err)
+		return
+	}
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+		return
+	}
+
+	query := h.Client.ItemRevision.Query().Where(itemrevision.ItemID(id))
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve revisions"})
+		h.Logger.Printf("Error counting revisions: %v", err)
+		return
+	}
+
+	revisions, err := query.
+		Order(itemrevision.ByRevision(sql.OrderDesc())).
+		Offset(params.Offset).
+		Limit(params.Limit).
+		All(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve revisions"})
+		h.Logger.Printf("Error retrieving revisions: %v", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, pageResponse{
+		Data:   revisions,
+		Total:  total,
+		Limit:  params.Limit,
+		Offset: &params.Offset,
+	})
+}
+
+// GetItemRevision retrieves a single revision of an item
+func (h *Handler) GetItemRevision(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("GetItemRevision called by user: %s (%s)", username, email)
+
+	revision, ok := h.findRevision(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, revision)
+}
+
+// RestoreItemRevision writes an old revision back to the item, creating a new revision.
+// Stock is left as is since it tracks physical inventory rather than catalog data.
+// The If-Match header must carry the item's current ETag.
+func (h *Handler) RestoreItemRevision(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("RestoreItemRevision called by user: %s (%s)", username, email)
+
+	revision, ok := h.findRevision(c)
+	if !ok {
+		return
+	}
+
+	version, ok := parseIfMatch(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, ok := h.authorizeItemWrite(ctx, c, revision.ItemID); !ok {
+		return
+	}
+
+	update := h.Client.Item.
+		UpdateOneID(revision.ItemID).
+		Where(versionPredicates(version)...).
+		SetName(revision.Name).
+		SetPrice(revision.Price).
+		SetCurrency(revision.Currency).
+		AddVersion(1)
+	if revision.Description != "" {
+		update.SetDescription(revision.Description)
+	} else {
+		update.ClearDescription()
+	}
+
+	restored, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			h.respondWriteMiss(ctx, c, revision.ItemID)
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore revision"})
+		h.Logger.Printf("Error restoring revision: %v", err)
+		return
+	}
+
+	c.Header("ETag", itemETag(restored))
+	c.JSON(http.StatusOK, newItemResponse(restored))
+}
+
+// findRevision loads the revision addressed by the :id and :rev path parameters.
+// It writes the error response and returns false when it cannot be found.
+func (h *Handler) findRevision(c *gin.Context) (*ent.ItemRevision, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return nil, false
+	}
+	rev, err := strconv.Atoi(c.Param("rev"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid revision format"})
+		return nil, false
+	}
+
+	// Revisions of soft-deleted items are hidden along with the item
+	revision, err := h.Client.ItemRevision.
+		Query().
+		Where(
+			itemrevision.ItemID(id),
+			itemrevision.Revision(rev),
+			itemrevision.HasItemWith(item.DeletedAtIsNil()),
+		).
+		Only(c.Request.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Revision not found"})
+			return nil, false
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve revision"})
+		h.Logger.Printf("Error retrieving revision: %v", err)
+		return nil, false
+	}
+	return revision, true
+}
@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"html"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"gin-crud/ent"
+	"gin-crud/ent/item"
+	"gin-crud/ent/predicate"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+)
+
+// maxSearchTerms caps the number of words taken from a search query
+const maxSearchTerms = 8
+
+// ts_headline marks matches with these control characters rather than <mark>,
+// so the snippet can be escaped before the real tags go in
+const (
+	headlineStart = "\x01"
+	headlineStop  = "\x02"
+)
+
+// headlineMarks turns the sentinels in an escaped ts_headline snippet into <mark> tags
+var headlineMarks = strings.NewReplacer(headlineStart, "<mark>", headlineStop, "</mark>")
+
+// searchHighlights holds the matched fields with the search terms wrapped in <mark> tags.
+// The item text is HTML-escaped, so <mark> is the only markup in a snippet.
+type searchHighlights struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// searchResult is a single ranked search match
+type searchResult struct {
+	Item       *itemResponse    `json:"item"`
+	Rank       float64          `json:"rank"`
+	Highlights searchHighlights `json:"highlights"`
+}
+
+// itemSearch builds a full-text search over item names and descriptions.
+// PostgreSQL uses the generated search_vector column; other dialects (SQLite
+// in tests) fall back to case-insensitive LIKE matching.
+type itemSearch struct {
+	terms []string
+	// fallback is set once the query has been built for a non-PostgreSQL dialect
+	fallback bool
+}
+
+// newItemSearch splits a query into lowercase words, dropping punctuation so
+// user input can never form tsquery operators
+func newItemSearch(q string) *itemSearch {
+	terms := strings.FieldsFunc(strings.ToLower(q), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(terms) > maxSearchTerms {
+		terms = terms[:maxSearchTerms]
+	}
+	return &itemSearch{terms: terms}
+}
+
+// tsquery matches every term as a prefix, e.g. "key:* & mech:*"
+func (s *itemSearch) tsquery(b *sql.Builder) {
+	parts := make([]string, len(s.terms))
+	for i, term := range s.terms {
+		parts[i] = term + ":*"
+	}
+	b.WriteString("to_tsquery('english', ").Arg(strings.Join(parts, " & ")).WriteString(")")
+}
+
+// predicate matches items containing every term
+func (s *itemSearch) predicate() predicate.Item {
+	return func(sel *sql.Selector) {
+		if sel.Dialect() == dialect.Postgres {
+			sel.Where(sql.P(func(b *sql.Builder) {
+				b.Ident(sel.C("search_vector")).WriteString(" @@ ")
+				s.tsquery(b)
+			}))
+			return
+		}
+		for _, term := range s.terms {
+			sel.Where(sql.Or(
+				sql.ContainsFold(sel.C(item.FieldName), term),
+				sql.ContainsFold(sel.C(item.FieldDescription), term),
+			))
+		}
+	}
+}
+
+// selectRanked selects the ID, rank and highlighted snippets of each match,
+// best matches first
+func (s *itemSearch) selectRanked(sel *sql.Selector) {
+	sel.Select(sel.C(item.FieldID))
+
+	if sel.Dialect() != dialect.Postgres {
+		// Without ts_rank, score 2 for each term in the name and 1 for each in the description
+		s.fallback = true
+		sel.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+			for i, term := range s.terms {
+				if i > 0 {
+					b.WriteString(" + ")
+				}
+				b.WriteString("CASE WHEN ").Join(sql.ContainsFold(sel.C(item.FieldName), term)).WriteString(" THEN 2 ELSE 0 END + ")
+				b.WriteString("CASE WHEN ").Join(sql.ContainsFold(sel.C(item.FieldDescription), term)).WriteString(" THEN 1 ELSE 0 END")
+			}
+		}), "search_rank")
+	} else {
+		sel.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_rank(").Ident(sel.C("search_vector")).Comma()
+			s.tsquery(b)
+			b.WriteString(")")
+		}), "search_rank")
+		sel.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_headline('english', ").Ident(sel.C(item.FieldName)).Comma()
+			s.tsquery(b)
+			b.WriteString(", ").Arg("StartSel=" + headlineStart + ", StopSel=" + headlineStop + ", HighlightAll=true").WriteString(")")
+		}), "name_snippet")
+		sel.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_headline('english', coalesce(").Ident(sel.C(item.FieldDescription)).WriteString(", '')").Comma()
+			s.tsquery(b)
+			b.WriteString(", ").Arg("StartSel=" + headlineStart + ", StopSel=" + headlineStop + ", MaxFragments=2, MaxWords=20, MinWords=5").WriteString(")")
+		}), "description_snippet")
+	}
+
+	sel.OrderExpr(sql.Expr("search_rank DESC")).OrderBy(sel.C(item.FieldID))
+}
+
+// headline escapes a ts_headline snippet and marks its matches
+func headline(snippet string) string {
+	return headlineMarks.Replace(html.EscapeString(snippet))
+}
+
+// highlight HTML-escapes text and wraps every case-insensitive occurrence of the
+// terms in <mark> tags. It is only used by the fallback search; PostgreSQL
+// builds its own snippets.
+func (s *itemSearch) highlight(text string) string {
+	lower := strings.ToLower(text)
+	if len(lower) != len(text) {
+		// Lowercasing changed the byte offsets, leave the text unmarked
+		return html.EscapeString(text)
+	}
+
+	marked := make([]bool, len(text))
+	for _, term := range s.terms {
+		for start := 0; ; {
+			i := strings.Index(lower[start:], term)
+			if i < 0 {
+				break
+			}
+			for j := start + i; j < start+i+len(term); j++ {
+				marked[j] = true
+			}
+			start += i + len(term)
+		}
+	}
+
+	// Escape each run of marked or unmarked text on its own so the tags stay outside it
+	var b strings.Builder
+	for start := 0; start < len(text); {
+		end := start + 1
+		for end < len(text) && marked[end] == marked[start] {
+			end++
+		}
+		if marked[start] {
+			b.WriteString("<mark>" + html.EscapeString(text[start:end]) + "</mark>")
+		} else {
+			b.WriteString(html.EscapeString(text[start:end]))
+		}
+		start = end
+	}
+	return b.String()
+}
+
+// SearchItems runs a ranked full-text search over item names and descriptions.
+// Every word in ?q= must match, as a prefix, in either field. Accepts limit and
+// offset as well as the same filters as GetItems.
+func (h *Handler) SearchItems(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("SearchItems called by user: %s (%s)", username, email)
+
+	search := newItemSearch(c.Query("q"))
+	if len(search.terms) == 0 {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "q must contain at least one word"})
+		return
+	}
+
+	params, err := parsePageParams(c)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if params.Cursor != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "cursor pagination is not supported for search"})
+		return
+	}
+
+	filters, err := parseItemFilters(c.Request.URL.Query())
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := c.Request.Context()
+	matches := h.Client.Item.
+		Query().
+		Where(filters...).
+		Where(search.predicate())
+
+	total, err := matches.Clone().Count(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to search items"})
+		h.Logger.Printf("Error counting search results: %v", err)
+		return
+	}
+
+	var hits []struct {
+		ID                 int64   `json:"id"`
+		Rank               float64 `json:"search_rank"`
+		NameSnippet        string  `json:"name_snippet"`
+		DescriptionSnippet string  `json:"description_snippet"`
+	}
+	err = matches.
+		Offset(params.Offset).
+		Limit(params.Limit).
+		Modify(search.selectRanked).
+		Scan(ctx, &hits)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to search items"})
+		h.Logger.Printf("Error searching items: %v", err)
+		return
+	}
+
+	// Load the full items and put them back in rank order
+	ids := make([]int64, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+	items, err := h.Client.Item.Query().Where(item.IDIn(ids...)).All(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to search items"})
+		h.Logger.Printf("Error retrieving search results: %v", err)
+		return
+	}
+	byID := make(map[int64]*ent.Item, len(items))
+	for _, it := range items {
+		byID[it.ID] = it
+	}
+
+	results := make([]searchResult, 0, len(hits))
+	for _, hit := range hits {
+		it, ok := byID[hit.ID]
+		if !ok {
+			// Deleted between the two queries
+			continue
+		}
+		highlights := searchHighlights{Name: headline(hit.NameSnippet), Description: headline(hit.DescriptionSnippet)}
+		if search.fallback {
+			highlights = searchHighlights{Name: search.highlight(it.Name), Description: search.highlight(it.Description)}
+		}
+		results = append(results, searchResult{Item: newItemResponse(it), Rank: hit.Rank, Highlights: highlights})
+	}
+
+	c.JSON(http.StatusOK, pageResponse{
+		Data:   results,
+		Total:  total,
+		Limit:  params.Limit,
+		Offset: &params.Offset,
+	})
+}
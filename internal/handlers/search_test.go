@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearchItemsFallbackEscapesHighlights(t *testing.T) {
+	h := newTestHandler(t)
+	createTestItem(t, h, "Mechanical <b>Keyboard</b>", `Clicky keys & a "key" light`)
+	createTestItem(t, h, "Mouse", "Wireless")
+
+	req := httptest.NewRequest(http.MethodGet, "/items/search?q=key", nil)
+	w := serve("/items/search", req, h.SearchItems)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+
+	var page struct {
+		Data []struct {
+			Highlights searchHighlights `json:"highlights"`
+		} `json:"data"`
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &page); err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 1 || len(page.Data) != 1 {
+		t.Fatalf("got %d of %d results, want 1 of 1", len(page.Data), page.Total)
+	}
+
+	got := page.Data[0].Highlights
+	if want := "Mechanical &lt;b&gt;<mark>Key</mark>board&lt;/b&gt;"; got.Name != want {
+		t.Errorf("name highlight = %q, want %q", got.Name, want)
+	}
+	if want := "Clicky <mark>key</mark>s &amp; a &#34;<mark>key</mark>&#34; light"; got.Description != want {
+		t.Errorf("description highlight = %q, want %q", got.Description, want)
+	}
+}
+
+func TestHeadlineEscapesSnippet(t *testing.T) {
+	got := headline("<i>" + headlineStart + "Key" + headlineStop + "board</i>")
+	if want := "&lt;i&gt;<mark>Key</mark>board&lt;/i&gt;"; got != want {
+		t.Errorf("headline = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"gin-crud/ent"
+	"gin-crud/ent/tag"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetTags lists all tags ordered by name
+func (h *Handler) GetTags(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("GetTags called by user: %s (%s)", username, email)
+
+	tags, err := h.Client.Tag.
+		Query().
+		Order(tag.ByName()).
+		All(c.Request.Context())
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tags"})
+		h.Logger.Printf("Error retrieving tags: %v", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+// CreateTag creates a new tag. Names are stored lowercase.
+func (h *Handler) CreateTag(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("CreateTag called by user: %s (%s)", username, email)
+
+	var input struct {
+		Name string `json:"name" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	created, err := h.Client.Tag.
+		Create().
+		SetName(normalizeTagName(input.Name)).
+		Save(c.Request.Context())
+	if err != nil {
+		h.respondTagWriteError(c, err, "Failed to create tag")
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+// UpdateTag renames a tag
+func (h *Handler) UpdateTag(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("UpdateTag called by user: %s (%s)", username, email)
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input struct {
+		Name string `json:"name" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	updated, err := h.Client.Tag.
+		UpdateOneID(id).
+		SetName(normalizeTagName(input.Name)).
+		Save(c.Request.Context())
+	if err != nil {
+		h.respondTagWriteError(c, err, "Failed to update tag")
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeleteTag deletes a tag and removes it from every item
+func (h *Handler) DeleteTag(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("DeleteTag called by user: %s (%s)", username, email)
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	if err := h.Client.Tag.DeleteOneID(id).Exec(c.Request.Context()); err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete tag"})
+		h.Logger.Printf("Error deleting tag: %v", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SetItemTags replaces the tags of an item.
+// Body: {"ids": [1, 2]}; an empty list removes every tag.
+// The If-Match header must carry the item's current ETag.
+func (h *Handler) SetItemTags(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("SetItemTags called by user: %s (%s)", username, email)
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input struct {
+		IDs []int `json:"ids"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil || input.IDs == nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	version, ok := parseIfMatch(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, ok := h.authorizeItemWrite(ctx, c, id); !ok {
+		return
+	}
+
+	found, err := h.Client.Tag.Query().Where(tag.IDIn(input.IDs...)).Count(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update tags"})
+		h.Logger.Printf("Error retrieving tags: %v", err)
+		return
+	}
+	if found != len(uniqueInts(input.IDs)) {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": "One or more tags do not exist"})
+		return
+	}
+
+	// Tags are part of the item, so replacing them is a new version
+	updated, err := h.Client.Item.
+		UpdateOneID(id).
+		Where(versionPredicates(version)...).
+		ClearTags().
+		AddTagIDs(uniqueInts(input.IDs)...).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			h.respondWriteMiss(ctx, c, id)
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update tags"})
+		h.Logger.Printf("Error updating item tags: %v", err)
+		return
+	}
+
+	tags, err := updated.QueryTags().Order(tag.ByName()).All(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tags"})
+		h.Logger.Printf("Error retrieving item tags: %v", err)
+		return
+	}
+
+	c.Header("ETag", itemETag(updated))
+	c.JSON(http.StatusOK, tags)
+}
+
+// normalizeTagName trims and lowercases a tag so "Sale" and "sale " are the same tag
+func normalizeTagName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// uniqueInts drops duplicate values, keeping the first occurrence
+func uniqueInts(vs []int) []int {
+	seen := make(map[int]bool, len(vs))
+	out := make([]int, 0, len(vs))
+	for _, v := range vs {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// respondTagWriteError maps ent errors from a tag write to a response
+func (h *Handler) respondTagWriteError(c *gin.Context, err error, message string) {
+	switch {
+	case ent.IsNotFound(err):
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+	case ent.IsValidationError(err):
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Name is required"})
+	case ent.IsConstraintError(err):
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "A tag with this name already exists"})
+	default:
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": message})
+		h.Logger.Printf("Error writing tag: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSetItemTagsChecksVersion(t *testing.T) {
+	h := newTestHandler(t)
+	it := createTestItem(t, h, "Keyboard", "")
+	sale := h.Client.Tag.Create().SetName("sale").SaveX(t.Context())
+
+	setTags := func(ifMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d/tags", it.ID), strings.NewReader(fmt.Sprintf(`{"ids": [%d]}`, sale.ID)))
+		req.Header.Set("Content-Type", "application/json")
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		return serve("/items/:id/tags", req, asAdmin, h.SetItemTags)
+	}
+
+	if w := setTags(""); w.Code != http.StatusPreconditionRequired {
+		t.Errorf("without If-Match: status = %d, want %d", w.Code, http.StatusPreconditionRequired)
+	}
+	w := setTags(itemETag(it))
+	if w.Code != http.StatusOK {
+		t.Fatalf("with current ETag: status = %d, body %s", w.Code, w.Body)
+	}
+	if got, want := w.Header().Get("ETag"), `"2"`; got != want {
+		t.Errorf("ETag = %s, want %s", got, want)
+	}
+	if w := setTags(itemETag(it)); w.Code != http.StatusPreconditionFailed {
+		t.Errorf("with stale ETag: status = %d, want %d", w.Code, http.StatusPreconditionFailed)
+	}
+}
+
+func TestTagFilterNormalizesNames(t *testing.T) {
+	h := newTestHandler(t)
+	it := createTestItem(t, h, "Keyboard", "")
+	h.Client.Tag.Create().SetName("sale").AddItems(it).SaveX(t.Context())
+	createTestItem(t, h, "Mouse", "")
+
+	for _, query := range []string{"tag=Sale", "tag=%20SALE%20", "tag[in]=Clearance,Sale"} {
+		req := httptest.NewRequest(http.MethodGet, "/items?"+query, nil)
+		w := serve("/items", req, h.GetItems)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: status = %d, body %s", query, w.Code, w.Body)
+		}
+		if !strings.Contains(w.Body.String(), `"name":"Keyboard"`) || strings.Contains(w.Body.String(), `"name":"Mouse"`) {
+			t.Errorf("%s: got %s, want only Keyboard", query, w.Body)
+		}
+	}
+}
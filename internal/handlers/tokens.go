@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gin-crud/ent"
+	"gin-crud/ent/refreshtoken"
+	"gin-crud/ent/revokedtoken"
+	"gin-crud/internal/sessions"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// refreshTokenTTL is the lifetime of a refresh token
+const refreshTokenTTL = 30 * 24 * time.Hour
+
+// tokenPair is returned to clients after login, registration and refresh
+type tokenPair struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// randomToken returns n random bytes encoded as URL-safe base64
+func randomToken(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashToken returns the hex SHA-256 of a refresh token as stored in the database
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueTokens signs a new access token for u and stores a matching refresh token.
+// An empty familyID starts a new refresh token family.
+func (h *Handler) issueTokens(ctx context.Context, client *ent.Client, u *ent.User, familyID string) (*tokenPair, error) {
+	jti, err := randomToken(16)
+	if err != nil {
+		return nil, fmt.Errorf("generating jti: %w", err)
+	}
+	if familyID == "" {
+		if familyID, err = randomToken(16); err != nil {
+			return nil, fmt.Errorf("generating family id: %w", err)
+		}
+	}
+
+	now := time.Now()
+	tokenString, err := h.Keys.Sign(jwt.MapClaims{
+		"sub":      u.ID,
+		"username": u.Username,
+		"email":    u.Email,
+		"role":     u.Role,
+		"jti":      jti,
+		"iat":      now.Unix(),
+		"exp":      now.Add(sessions.AccessTokenTTL).Unix(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("signing access token: %w", err)
+	}
+
+	refresh, err := randomToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("generating refresh token: %w", err)
+	}
+	err = client.RefreshToken.
+		Create().
+		SetTokenHash(hashToken(refresh)).
+		SetFamilyID(familyID).
+		SetAccessJti(jti).
+		SetUserID(u.ID).
+		SetExpiresAt(now.Add(refreshTokenTTL)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("storing refresh token: %w", err)
+	}
+
+	return &tokenPair{
+		Token:        tokenString,
+		RefreshToken: refresh,
+		ExpiresIn:    int(sessions.AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+// RefreshToken exchanges a refresh token for a new access and refresh token.
+// Presenting an already rotated token revokes its whole family.
+func (h *Handler) RefreshToken(c *gin.Context) {
+	var body struct {
+		RefreshToken string `json:"refresh_token" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	tx, err := h.Client.Tx(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh token"})
+		h.Logger.Printf("Error starting transaction: %v", err)
+		return
+	}
+	defer tx.Rollback()
+
+	stored, err := tx.RefreshToken.
+		Query().
+		Where(refreshtoken.TokenHash(hashToken(body.RefreshToken))).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh token"})
+		h.Logger.Printf("Error fetching refresh token: %v", err)
+		return
+	}
+
+	if stored.RevokedAt != nil {
+		// The token was already rotated or revoked, so it may have been stolen
+		h.revokeReusedFamily(c, tx, stored)
+		return
+	}
+
+	if time.Now().After(stored.ExpiresAt) {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Refresh token expired"})
+		return
+	}
+
+	// Rotate: the presented token can never be used again. The guard makes
+	// rotation atomic, so of two concurrent refreshes only one gets a child
+	// token and the other is treated as reuse.
+	rotated, err := tx.RefreshToken.
+		Update().
+		Where(refreshtoken.ID(stored.ID), refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh token"})
+		h.Logger.Printf("Error rotating refresh token: %v", err)
+		return
+	}
+	if rotated == 0 {
+		h.revokeReusedFamily(c, tx, stored)
+		return
+	}
+
+	tokens, err := h.issueTokens(ctx, tx.Client(), stored.Edges.User, stored.FamilyID)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+		h.Logger.Printf("Error generating token: %v", err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh token"})
+		h.Logger.Printf("Error committing refresh: %v", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
+
+// revokeReusedFamily answers a refresh with an already rotated token by
+// revoking its whole family
+func (h *Handler) revokeReusedFamily(c *gin.Context, tx *ent.Tx, stored *ent.RefreshToken) {
+	h.Logger.Printf("Refresh token reuse detected for user %d, revoking family %s", stored.UserID, stored.FamilyID)
+	if err := sessions.RevokeFamily(c.Request.Context(), tx.Client(), stored.FamilyID); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh token"})
+		h.Logger.Printf("Error revoking token family: %v", err)
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		h.Logger.Printf("Error committing token revocation: %v", err)
+	}
+	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+}
+
+// Logout revokes the refresh token family of the given refresh token
+// together with the access token used to call this endpoint
+func (h *Handler) Logout(c *gin.Context) {
+	var body struct {
+		RefreshToken string `json:"refresh_token" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	tx, err := h.Client.Tx(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to logout"})
+		h.Logger.Printf("Error starting transaction: %v", err)
+		return
+	}
+	defer tx.Rollback()
+
+	stored, err := tx.RefreshToken.
+		Query().
+		Where(refreshtoken.TokenHash(hashToken(body.RefreshToken))).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to logout"})
+		h.Logger.Printf("Error fetching refresh token: %v", err)
+		return
+	}
+
+	// Only let users revoke their own sessions
+	if stored != nil && stored.UserID == c.GetInt("userID") {
+		if err := sessions.RevokeFamily(ctx, tx.Client(), stored.FamilyID); err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to logout"})
+			h.Logger.Printf("Error revoking token family: %v", err)
+			return
+		}
+	}
+
+	if jti := c.GetString("jti"); jti != "" {
+		if err := sessions.RevokeAccessToken(ctx, tx.Client(), jti, time.Now().Add(sessions.AccessTokenTTL)); err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to logout"})
+			h.Logger.Printf("Error revoking access token: %v", err)
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to logout"})
+		h.Logger.Printf("Error committing logout: %v", err)
+		return
+	}
+
+	// Expired entries no longer need to be kept around
+	if _, err := h.Client.RevokedToken.Delete().Where(revokedtoken.ExpiresAtLT(time.Now())).Exec(ctx); err != nil {
+		h.Logger.Printf("Error purging revoked tokens: %v", err)
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"gin-crud/ent"
+	"gin-crud/ent/user"
+	"gin-crud/internal/sessions"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetUserRole grants a user a new role. Tokens carry the role, so the user is
+// signed out of every session and picks up the new role on the next login.
+func (h *Handler) SetUserRole(c *gin.Context) {
+	// Log the user making the request using JWT data from context
+	username, _ := c.Request.Context().Value("username").(string)
+	email, _ := c.Request.Context().Value("email").(string)
+	h.Logger.Printf("SetUserRole called by user: %s (%s)", username, email)
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input struct {
+		Role string `json:"role" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+	role := user.Role(input.Role)
+	if err := user.RoleValidator(role); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Role must be admin, editor or viewer"})
+		return
+	}
+
+	// Admins cannot lock themselves out
+	if id == c.GetInt("userID") {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Cannot change your own role"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	tx, err := h.Client.Tx(ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update role"})
+		h.Logger.Printf("Error starting transaction: %v", err)
+		return
+	}
+	defer tx.Rollback()
+
+	updated, err := tx.User.UpdateOneID(id).SetRole(role).Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update role"})
+		h.Logger.Printf("Error updating role: %v", err)
+		return
+	}
+	if err := sessions.RevokeUser(ctx, tx.Client(), id); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update role"})
+		h.Logger.Printf("Error revoking sessions: %v", err)
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to update role"})
+		h.Logger.Printf("Error committing role change: %v", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"id":       updated.ID,
+		"username": updated.Username,
+		"email":    updated.Email,
+		"role":     updated.Role,
+	})
+}
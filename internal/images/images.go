@@ -0,0 +1,118 @@
+package images
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+// maxPixels guards against decompression bombs: small files that decode to huge images
+const maxPixels = 40_000_000
+
+// ErrUnsupported is returned for files that are not a JPEG, PNG or GIF image
+var ErrUnsupported = errors.New("unsupported image type, use JPEG, PNG or GIF")
+
+// decoders maps the sniffed content type to its decoder. The type is taken
+// from the file contents, never from the client's Content-Type header.
+var decoders = map[string]func([]byte) (image.Image, error){
+	"image/jpeg": func(b []byte) (image.Image, error) { return jpeg.Decode(bytes.NewReader(b)) },
+	"image/png":  func(b []byte) (image.Image, error) { return png.Decode(bytes.NewReader(b)) },
+	"image/gif":  func(b []byte) (image.Image, error) { return gif.Decode(bytes.NewReader(b)) },
+}
+
+// Image is a decoded upload
+type Image struct {
+	ContentType string
+	Image       image.Image
+}
+
+// Decode sniffs the content type of data and decodes it
+func Decode(data []byte) (*Image, error) {
+	contentType := http.DetectContentType(data)
+	decode, ok := decoders[contentType]
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.New("the image could not be decoded")
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, errors.New("the image dimensions are too large")
+	}
+
+	img, err := decode(data)
+	if err != nil {
+		return nil, errors.New("the image could not be decoded")
+	}
+	return &Image{ContentType: contentType, Image: img}, nil
+}
+
+// Thumbnail scales the image down to fit within size x size pixels, keeping
+// the aspect ratio, and encodes it. JPEGs stay JPEG; PNGs and GIFs become PNG
+// to keep transparency. It returns the encoded bytes and their content type.
+func (i *Image) Thumbnail(size int) ([]byte, string, error) {
+	thumb := fit(i.Image, size)
+
+	var buf bytes.Buffer
+	if i.ContentType == "image/jpeg" {
+		if err := jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: 85}); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), "image/jpeg", nil
+	}
+	if err := png.Encode(&buf, thumb); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), "image/png", nil
+}
+
+// fit box-filters src down to fit within size x size. Images that already
+// fit are returned unchanged.
+func fit(src image.Image, size int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= size && h <= size {
+		return src
+	}
+
+	dw, dh := size, h*size/w
+	if h > w {
+		dw, dh = w*size/h, size
+	}
+	dw, dh = max(dw, 1), max(dh, 1)
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0, y1 := b.Min.Y+y*h/dh, b.Min.Y+(y+1)*h/dh
+		for x := 0; x < dw; x++ {
+			x0, x1 := b.Min.X+x*w/dw, b.Min.X+(x+1)*w/dw
+
+			// Average every source pixel that falls in this destination pixel
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < max(y1, y0+1); sy++ {
+				for sx := x0; sx < max(x1, x0+1); sx++ {
+					c := color.RGBA64Model.Convert(src.At(sx, sy)).(color.RGBA64)
+					r += uint64(c.R)
+					g += uint64(c.G)
+					bl += uint64(c.B)
+					a += uint64(c.A)
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(bl / n >> 8),
+				A: uint8(a / n >> 8),
+			})
+		}
+	}
+	return dst
+}
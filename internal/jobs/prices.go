@@ -0,0 +1,154 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gin-crud/ent"
+	"gin-crud/ent/item"
+	"gin-crud/ent/pricechange"
+	"gin-crud/internal/app"
+)
+
+// StartPriceScheduler periodically applies scheduled price changes that are
+// due and restores the previous price of those that ended.
+// The job stops when ctx is cancelled and
+// closes the returned channel once it has stopped.
+func StartPriceScheduler(ctx context.Context, a *app.App) <-chan struct{} {
+	interval := a.Config.Jobs.PriceSchedulerInterval
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := ApplyPriceChanges(ctx, a, time.Now()); err != nil {
+				a.Logger.Printf("Error applying price changes: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return done
+}
+
+// ApplyPriceChanges starts every scheduled change due at now and ends every
+// active change whose window closed. Each change is handled in its own
+// transaction, so one failure does not hold up the others.
+func ApplyPriceChanges(ctx context.Context, a *app.App, now time.Time) error {
+	due, err := a.Client.PriceChange.
+		Query().
+		Where(
+			pricechange.StatusEQ(pricechange.StatusScheduled),
+			pricechange.EffectiveFromLTE(now),
+		).
+		Order(pricechange.ByEffectiveFrom()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pc := range due {
+		if err := withTx(ctx, a.Client, func(tx *ent.Tx) error { return startPriceChange(ctx, tx, pc, now) }); err != nil {
+			a.Logger.Printf("Error starting price change %d: %v", pc.ID, err)
+		}
+	}
+
+	expired, err := a.Client.PriceChange.
+		Query().
+		Where(
+			pricechange.StatusEQ(pricechange.StatusActive),
+			pricechange.EffectiveToLTE(now),
+		).
+		Order(pricechange.ByEffectiveTo()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pc := range expired {
+		if err := withTx(ctx, a.Client, func(tx *ent.Tx) error { return endPriceChange(ctx, tx, pc, now) }); err != nil {
+			a.Logger.Printf("Error ending price change %d: %v", pc.ID, err)
+		}
+	}
+	return nil
+}
+
+// startPriceChange sets the item's price and records the one it replaces
+func startPriceChange(ctx context.Context, tx *ent.Tx, pc *ent.PriceChange, now time.Time) error {
+	// Claiming the change with a conditional update keeps two instances from applying it twice
+	claim := tx.PriceChange.
+		Update().
+		Where(pricechange.ID(pc.ID), pricechange.StatusEQ(pricechange.StatusScheduled))
+
+	current, err := tx.Item.Get(ctx, pc.ItemID)
+	missed := pc.EffectiveTo != nil && !pc.EffectiveTo.After(now)
+	if ent.IsNotFound(err) || missed {
+		// The item is gone or the window passed while the job was not running
+		_, err := claim.SetStatus(pricechange.StatusEnded).SetEndedAt(now).Save(ctx)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	claim.SetPreviousPrice(current.Price).SetAppliedAt(now)
+	if pc.EffectiveTo == nil {
+		// Permanent changes have nothing to restore
+		claim.SetStatus(pricechange.StatusEnded).SetEndedAt(now)
+	} else {
+		claim.SetStatus(pricechange.StatusActive)
+	}
+	claimed, err := claim.Save(ctx)
+	if err != nil || claimed == 0 {
+		return err
+	}
+
+	return tx.Item.
+		UpdateOneID(pc.ItemID).
+		SetPrice(pc.Price).
+		AddVersion(1).
+		Exec(ctx)
+}
+
+// endPriceChange restores the price the item had before the change. If the
+// price was edited while the change was active, the edit is kept.
+func endPriceChange(ctx context.Context, tx *ent.Tx, pc *ent.PriceChange, now time.Time) error {
+	claimed, err := tx.PriceChange.
+		Update().
+		Where(pricechange.ID(pc.ID), pricechange.StatusEQ(pricechange.StatusActive)).
+		SetStatus(pricechange.StatusEnded).
+		SetEndedAt(now).
+		Save(ctx)
+	if err != nil || claimed == 0 || pc.PreviousPrice == nil {
+		return err
+	}
+
+	_, err = tx.Item.
+		Update().
+		Where(item.ID(pc.ItemID), item.DeletedAtIsNil(), item.Price(pc.Price)).
+		SetPrice(*pc.PreviousPrice).
+		AddVersion(1).
+		Save(ctx)
+	return err
+}
+
+// withTx runs fn in a transaction, committing when it returns nil
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,75 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"gin-crud/ent/item"
+	"gin-crud/ent/itemimage"
+	"gin-crud/ent/schema"
+	"gin-crud/internal/app"
+)
+
+// StartItemPurge periodically removes items that were soft-deleted longer
+// than the configured retention ago. The job stops when ctx is cancelled and
+// closes the returned channel once it has stopped.
+func StartItemPurge(ctx context.Context, a *app.App) <-chan struct{} {
+	retention := a.Config.Jobs.ItemRetention
+	interval := a.Config.Jobs.PurgeInterval
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if n, err := PurgeDeletedItems(ctx, a, retention); err != nil {
+				a.Logger.Printf("Error purging deleted items: %v", err)
+			} else if n > 0 {
+				a.Logger.Printf("Purged %d deleted items", n)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return done
+}
+
+// PurgeDeletedItems permanently deletes items soft-deleted before now - retention.
+// Their images are removed from storage once the rows are gone.
+func PurgeDeletedItems(ctx context.Context, a *app.App, retention time.Duration) (int, error) {
+	cutoff := time.Now().Add(-retention)
+	ctx = schema.SkipSoftDelete(ctx)
+
+	imgs, err := a.Client.ItemImage.
+		Query().
+		Where(itemimage.HasItemWith(item.DeletedAtLT(cutoff))).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := a.Client.Item.
+		Delete().
+		Where(item.DeletedAtLT(cutoff)).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if a.Storage != nil {
+		for _, img := range imgs {
+			for _, key := range []string{img.Key, img.ThumbnailKey} {
+				if err := a.Storage.Delete(ctx, key); err != nil {
+					a.Logger.Printf("Error deleting stored file %s: %v", key, err)
+				}
+			}
+		}
+	}
+	return n, nil
+}
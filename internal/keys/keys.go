@@ -0,0 +1,234 @@
+package keys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gin-crud/internal/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Key is a single signing key identified by its kid
+type Key struct {
+	ID     string
+	Method jwt.SigningMethod
+	// Private is nil for verify-only keys
+	Private crypto.Signer
+	Public  crypto.PublicKey
+	// RetiredAt is set once the key stopped being used for signing
+	RetiredAt *time.Time
+}
+
+// KeySet holds every known key and the kid of the one used for signing
+type KeySet struct {
+	keys        map[string]*Key
+	active      string
+	gracePeriod time.Duration
+}
+
+// Load loads the key set used to sign and verify JWTs. Keys are read from
+// the <kid>.pem files in cfg.KeysDir.
+//
+// Without a keys directory an ephemeral Ed25519 key is generated, which is only
+// suitable for development since tokens do not survive a restart; logger
+// warns when that happens.
+func Load(cfg config.JWTConfig, logger *log.Logger) (*KeySet, error) {
+	grace := cfg.KeyGrace
+	dir := cfg.KeysDir
+	if dir == "" {
+		logger.Println("JWT_KEYS_DIR is not set, using an ephemeral signing key")
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		key := &Key{ID: "ephemeral", Method: jwt.SigningMethodEdDSA, Private: priv, Public: priv.Public()}
+		return NewKeySet([]*Key{key}, key.ID, grace)
+	}
+
+	keys, err := LoadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	retired, err := parseRetired(cfg.RetiredKeys)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if at, ok := retired[key.ID]; ok {
+			key.RetiredAt = &at
+		}
+	}
+
+	return NewKeySet(keys, cfg.ActiveKID, grace)
+}
+
+// NewKeySet validates keys and returns a set that signs with the active kid
+func NewKeySet(keys []*Key, active string, gracePeriod time.Duration) (*KeySet, error) {
+	set := &KeySet{keys: make(map[string]*Key), active: active, gracePeriod: gracePeriod}
+	for _, key := range keys {
+		if _, ok := set.keys[key.ID]; ok {
+			return nil, fmt.Errorf("duplicate key id %q", key.ID)
+		}
+		set.keys[key.ID] = key
+	}
+
+	if active == "" {
+		return nil, errors.New("no active key id configured")
+	}
+	key, ok := set.keys[active]
+	if !ok {
+		return nil, fmt.Errorf("active key %q not found", active)
+	}
+	if key.Private == nil {
+		return nil, fmt.Errorf("active key %q has no private key", active)
+	}
+	if key.RetiredAt != nil {
+		return nil, fmt.Errorf("active key %q is retired", active)
+	}
+	return set, nil
+}
+
+// LoadDir reads every <kid>.pem file in dir.
+// Files may hold a PKCS#8 or PKCS#1 private key, or a PKIX public key for
+// keys that are only used for verification.
+func LoadDir(dir string) ([]*Key, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var keys []*Key
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+		key, err := parseKey(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no keys found in %s", dir)
+	}
+	return keys, nil
+}
+
+func parseKey(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key := &Key{ID: kid}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Method, key.Private, key.Public = jwt.SigningMethodRS256, k, k.Public()
+	case ed25519.PrivateKey:
+		key.Method, key.Private, key.Public = jwt.SigningMethodEdDSA, k, k.Public()
+	case *rsa.PublicKey:
+		key.Method, key.Public = jwt.SigningMethodRS256, k
+	case ed25519.PublicKey:
+		key.Method, key.Public = jwt.SigningMethodEdDSA, k
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+	return key, nil
+}
+
+func parseRetired(value string) (map[string]time.Time, error) {
+	retired := make(map[string]time.Time)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kid, at, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid JWT_RETIRED_KEYS entry %q", entry)
+		}
+		t, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retirement time for key %q: %w", kid, err)
+		}
+		retired[kid] = t
+	}
+	return retired, nil
+}
+
+// usable reports whether a key may still verify tokens at now
+func (s *KeySet) usable(key *Key, now time.Time) bool {
+	return key.RetiredAt == nil || now.Before(key.RetiredAt.Add(s.gracePeriod))
+}
+
+// Sign signs claims with the active key and sets the kid header
+func (s *KeySet) Sign(claims jwt.Claims) (string, error) {
+	key := s.keys[s.active]
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.Private)
+}
+
+// Keyfunc resolves the verification key for a token from its kid header.
+// It can be passed directly to jwt.Parse.
+func (s *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	if !s.usable(key, time.Now()) {
+		return nil, fmt.Errorf("key %q has been retired", kid)
+	}
+	return key.Public, nil
+}
+
+// Algorithms lists the signing algorithms of the keys in the set
+func (s *KeySet) Algorithms() []string {
+	seen := make(map[string]bool)
+	var algs []string
+	for _, key := range s.keys {
+		if alg := key.Method.Alg(); !seen[alg] {
+			seen[alg] = true
+			algs = append(algs, alg)
+		}
+	}
+	sort.Strings(algs)
+	return algs
+}
@@ -2,19 +2,39 @@ package middleware
 
 import (
 	"context"
-	"fmt"
+	"crypto/x509"
 	"net/http"
 	"strings"
 
-	"gin-crud/internal/config"
+	"gin-crud/ent"
+	"gin-crud/ent/revokedtoken"
+	"gin-crud/ent/user"
+	"gin-crud/internal/app"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func JWTMiddleware() gin.HandlerFunc {
+// JWTMiddleware authenticates requests with the bearer token in the
+// Authorization header, using the keys and database of a.
+//
+// With mutual TLS, a verified client certificate is recorded as the
+// request's clientSubject. Requests without an Authorization header are then
+// authenticated as the user whose username is the certificate's common name.
+func JWTMiddleware(a *app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		cert := clientCertificate(c)
+		if cert != nil {
+			subject := cert.Subject.String()
+			c.Set("clientSubject", subject)
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), "clientSubject", subject))
+		}
+
 		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" && cert != nil {
+			authenticateClientCert(a, c, cert)
+			return
+		}
 		if authHeader == "" {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header is required"})
 			return
@@ -27,14 +47,8 @@ func JWTMiddleware() gin.HandlerFunc {
 
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
-		secret := config.GetEnv("JWT_SECRET")
-
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(secret), nil
-		})
+		// Tokens are verified with the public key matching their kid header
+		token, err := jwt.Parse(tokenString, a.Keys.Keyfunc, jwt.WithValidMethods(a.Keys.Algorithms()))
 
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
@@ -52,15 +66,97 @@ func JWTMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		ctx := context.WithValue(c.Request.Context(), "userID", claims["sub"])
-		ctx = context.WithValue(ctx, "username", claims["username"])
-		ctx = context.WithValue(ctx, "email", claims["email"])
-		c.Request = c.Request.WithContext(ctx)
+		// Numeric JSON claims are decoded as float64
+		sub, ok := claims["sub"].(float64)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
+			return
+		}
+		userID := int(sub)
+
+		// Tokens revoked through logout or refresh token reuse are rejected
+		jti, _ := claims["jti"].(string)
+		if jti == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
+			return
+		}
+		revoked, err := a.Client.RevokedToken.
+			Query().
+			Where(revokedtoken.Jti(jti)).
+			Exist(c.Request.Context())
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate token"})
+			a.Logger.Printf("Error checking token revocation: %v", err)
+			return
+		}
+		if revoked {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Token has been revoked"})
+			return
+		}
 
-		c.Set("userID", claims["sub"])
-		c.Set("username", claims["username"])
-		c.Set("email", claims["email"])
+		setPrincipal(c, userID, claims["username"], claims["email"], claims["role"])
+		c.Set("jti", jti)
 
 		c.Next()
 	}
 }
+
+// clientCertificate returns the client certificate of a request if the TLS
+// handshake verified it against the client CA bundle
+func clientCertificate(c *gin.Context) *x509.Certificate {
+	if c.Request.TLS == nil || len(c.Request.TLS.VerifiedChains) == 0 {
+		return nil
+	}
+	return c.Request.TLS.VerifiedChains[0][0]
+}
+
+// authenticateClientCert lets the request through as the user named by the
+// certificate's common name
+func authenticateClientCert(a *app.App, c *gin.Context, cert *x509.Certificate) {
+	u, err := a.Client.User.
+		Query().
+		Where(user.Username(cert.Subject.CommonName)).
+		Only(c.Request.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Client certificate does not match a user"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate client certificate"})
+		a.Logger.Printf("Error retrieving certificate user: %v", err)
+		return
+	}
+
+	setPrincipal(c, u.ID, u.Username, u.Email, string(u.Role))
+	c.Next()
+}
+
+// setPrincipal stores the authenticated user in the request context for handlers and hooks
+func setPrincipal(c *gin.Context, userID int, username, email, role any) {
+	ctx := context.WithValue(c.Request.Context(), "userID", userID)
+	ctx = context.WithValue(ctx, "username", username)
+	ctx = context.WithValue(ctx, "email", email)
+	ctx = context.WithValue(ctx, "role", role)
+	c.Request = c.Request.WithContext(ctx)
+
+	c.Set("userID", userID)
+	c.Set("username", username)
+	c.Set("email", email)
+	c.Set("role", role)
+}
+
+// RequireRole only lets requests through whose JWT role claim is one of roles.
+// It must be registered after JWTMiddleware.
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := c.GetString("role")
+		for _, allowed := range roles {
+			if role == allowed {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+	}
+}
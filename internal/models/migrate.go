@@ -0,0 +1,482 @@
+package models
+
+import (
+	"context"
+	"crypto/sha256"
+	stdsql "database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+// migrationFiles are the versioned migrations, generated from the ent schema
+// by ent/migrate/main.go in golang-migrate format, <version>_<name>.up.sql
+// and .down.sql, together with the atlas.sum integrity file
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+// migrationsTable records the applied migrations
+const migrationsTable = "schema_migrations"
+
+// migrationLock is the advisory lock key held while migrating, so servers
+// starting at the same time do not apply a migration twice
+const migrationLock = 7204213150
+
+// ErrSchemaBehind is returned by CheckSchema when migrations are pending
+var ErrSchemaBehind = errors.New("database schema is behind")
+
+// Migration is a versioned schema change. Checksum covers both the up and
+// the down SQL.
+type Migration struct {
+	Version  string
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// MigrationStatus is a migration and when it was applied, if it was
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Migrations returns the embedded migrations ordered by version. It fails if
+// a file was changed without updating atlas.sum.
+func Migrations() ([]Migration, error) {
+	dir, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySum(dir); err != nil {
+		return nil, err
+	}
+
+	names, err := fs.Glob(dir, "*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	migrations := make([]Migration, 0, len(names))
+	for _, name := range names {
+		base := strings.TrimSuffix(name, ".up.sql")
+		version, desc, _ := strings.Cut(base, "_")
+		up, err := fs.ReadFile(dir, name)
+		if err != nil {
+			return nil, err
+		}
+		down, err := fs.ReadFile(dir, base+".down.sql")
+		if err != nil {
+			return nil, fmt.Errorf("migration %s has no down file: %w", version, err)
+		}
+		migrations = append(migrations, Migration{
+			Version:  version,
+			Name:     desc,
+			Up:       string(up),
+			Down:     string(down),
+			Checksum: migrationChecksum(up, down),
+		})
+	}
+	return migrations, nil
+}
+
+// migrationChecksum hashes the up and down SQL of a migration
+func migrationChecksum(up, down []byte) string {
+	sum := sha256.New()
+	sum.Write(up)
+	sum.Write([]byte{0})
+	sum.Write(down)
+	return hex.EncodeToString(sum.Sum(nil))
+}
+
+// verifySum checks the migration files against atlas.sum, the same way the
+// atlas CLI does
+func verifySum(dir fs.FS) error {
+	data, err := fs.ReadFile(dir, migrate.HashFileName)
+	if err != nil {
+		return err
+	}
+	var want migrate.HashFile
+	if err := want.UnmarshalText(data); err != nil {
+		return fmt.Errorf("%s: %w", migrate.HashFileName, err)
+	}
+
+	names, err := fs.Glob(dir, "*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+	files := make([]migrate.File, 0, len(names))
+	for _, name := range names {
+		data, err := fs.ReadFile(dir, name)
+		if err != nil {
+			return err
+		}
+		files = append(files, migrate.NewLocalFile(name, data))
+	}
+	got, err := migrate.NewHashFile(files)
+	if err != nil {
+		return err
+	}
+	if got.Sum() != want.Sum() {
+		return fmt.Errorf("migration files do not match %s, regenerate it with \"go run -mod=mod ent/migrate/main.go -hash\"", migrate.HashFileName)
+	}
+	return nil
+}
+
+// Status lists every migration and whether it was applied. It fails if an
+// applied migration was edited since or is unknown to this build.
+func (db *DB) Status(ctx context.Context) ([]MigrationStatus, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+	conn := db.drv.DB()
+	if err := createMigrationsTable(ctx, conn); err != nil {
+		return nil, err
+	}
+	return migrationStatus(ctx, conn, migrations)
+}
+
+// Pending returns the migrations that have not been applied yet
+func (db *DB) Pending(ctx context.Context) ([]Migration, error) {
+	status, err := db.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return pending(status), nil
+}
+
+// CheckSchema returns ErrSchemaBehind if the database is missing migrations
+// this build expects, so the server never runs against an older schema
+func (db *DB) CheckSchema(ctx context.Context) error {
+	migrations, err := db.Pending(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check migrations: %w", err)
+	}
+	if len(migrations) > 0 {
+		return fmt.Errorf("%w: %d pending migrations, starting with %s_%s; run \"gin-crud migrate up\"",
+			ErrSchemaBehind, len(migrations), migrations[0].Version, migrations[0].Name)
+	}
+	return nil
+}
+
+// Migrate applies the pending migrations in order, each in its own
+// transaction, and returns the ones it applied
+func (db *DB) Migrate(ctx context.Context) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	err = db.withMigrationLock(ctx, func(conn *stdsql.Conn) error {
+		status, err := migrationStatus(ctx, conn, migrations)
+		if err != nil {
+			return err
+		}
+		for _, m := range pending(status) {
+			err := inTx(ctx, conn, func(tx *stdsql.Tx) error {
+				if _, err := tx.ExecContext(ctx, m.Up); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx,
+					`INSERT INTO `+migrationsTable+` (version, name, checksum) VALUES ($1, $2, $3)`,
+					m.Version, m.Name, m.Checksum)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("migration %s_%s failed: %w", m.Version, m.Name, err)
+			}
+			applied = append(applied, m)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Rollback reverts the last steps applied migrations, newest first, and
+// returns the ones it reverted
+func (db *DB) Rollback(ctx context.Context, steps int) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	err = db.withMigrationLock(ctx, func(conn *stdsql.Conn) error {
+		status, err := migrationStatus(ctx, conn, migrations)
+		if err != nil {
+			return err
+		}
+		for i := len(status) - 1; i >= 0 && len(reverted) < steps; i-- {
+			m := status[i]
+			if m.AppliedAt == nil {
+				continue
+			}
+			err := inTx(ctx, conn, func(tx *stdsql.Tx) error {
+				if _, err := tx.ExecContext(ctx, m.Down); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx, `DELETE FROM `+migrationsTable+` WHERE version = $1`, m.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("reverting migration %s_%s failed: %w", m.Version, m.Name, err)
+			}
+			reverted = append(reverted, m.Migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Baseline records the migrations up to and including version as applied
+// without running them. It is meant for databases created before versioned
+// migrations and refuses to run once any migration is recorded, or when the
+// live tables and columns differ from what those migrations create.
+func (db *DB) Baseline(ctx context.Context, version string) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+	var marked []Migration
+	for _, m := range migrations {
+		if m.Version <= version {
+			marked = append(marked, m)
+		}
+	}
+	if len(marked) == 0 || marked[len(marked)-1].Version != version {
+		return nil, fmt.Errorf("unknown migration version %s", version)
+	}
+
+	err = db.withMigrationLock(ctx, func(conn *stdsql.Conn) error {
+		var count int
+		if err := conn.QueryRowContext(ctx, `SELECT count(*) FROM `+migrationsTable).Scan(&count); err != nil {
+			return err
+		}
+		if count > 0 {
+			return errors.New("migrations were already applied, baseline only applies to unversioned databases")
+		}
+		if err := verifyBaseline(ctx, conn, marked); err != nil {
+			return err
+		}
+		return inTx(ctx, conn, func(tx *stdsql.Tx) error {
+			for _, m := range marked {
+				_, err := tx.ExecContext(ctx,
+					`INSERT INTO `+migrationsTable+` (version, name, checksum) VALUES ($1, $2, $3)`,
+					m.Version, m.Name, m.Checksum)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return marked, nil
+}
+
+// baselineSchema is the scratch schema verifyBaseline replays migrations in
+const baselineSchema = "gin_crud_baseline"
+
+// verifyBaseline replays migrations in a scratch schema, inside a transaction
+// that is always rolled back, and compares the columns they create with the
+// live ones. It needs permission to create schemas in the database.
+func verifyBaseline(ctx context.Context, conn *stdsql.Conn, migrations []Migration) error {
+	var live string
+	if err := conn.QueryRowContext(ctx, `SELECT current_schema()`).Scan(&live); err != nil {
+		return err
+	}
+	have, err := schemaColumns(ctx, conn, live)
+	if err != nil {
+		return err
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `CREATE SCHEMA `+baselineSchema); err != nil {
+		return fmt.Errorf("failed to create scratch schema: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `SET LOCAL search_path TO `+baselineSchema); err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if _, err := tx.ExecContext(ctx, m.Up); err != nil {
+			return fmt.Errorf("failed to replay migration %s_%s: %w", m.Version, m.Name, err)
+		}
+	}
+	want, err := schemaColumns(ctx, tx, baselineSchema)
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+	for column, typ := range want {
+		switch got, ok := have[column]; {
+		case !ok:
+			problems = append(problems, "missing column "+column)
+		case got != typ:
+			problems = append(problems, fmt.Sprintf("column %s is %s, expected %s", column, got, typ))
+		}
+	}
+	for column := range have {
+		if _, ok := want[column]; !ok {
+			problems = append(problems, "unexpected column "+column)
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("schema does not match migration %s:\n  %s",
+			migrations[len(migrations)-1].Version, strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// schemaColumns returns the type of every column of the tables in schema,
+// keyed by table.column, leaving out the migrations table
+func schemaColumns(ctx context.Context, q queryer, schema string) (map[string]string, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT table_name, column_name, data_type, is_nullable FROM information_schema.columns
+		WHERE table_schema = $1 AND table_name <> $2`, schema, migrationsTable)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make(map[string]string)
+	for rows.Next() {
+		var table, column, typ, nullable string
+		if err := rows.Scan(&table, &column, &typ, &nullable); err != nil {
+			return nil, err
+		}
+		if nullable == "YES" {
+			typ += " NULL"
+		}
+		columns[table+"."+column] = typ
+	}
+	return columns, rows.Err()
+}
+
+// queryer is satisfied by *sql.DB, *sql.Conn and *sql.Tx
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error)
+}
+
+func createMigrationsTable(ctx context.Context, q queryer) error {
+	_, err := q.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+migrationsTable+` (
+		version character varying PRIMARY KEY,
+		name character varying NOT NULL,
+		checksum character varying NOT NULL,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`)
+	return err
+}
+
+// migrationStatus pairs migrations with the rows of the migrations table and
+// verifies the checksums of the applied ones
+func migrationStatus(ctx context.Context, q queryer, migrations []Migration) ([]MigrationStatus, error) {
+	rows, err := q.QueryContext(ctx, `SELECT version, checksum, applied_at FROM `+migrationsTable+` ORDER BY version`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type appliedRow struct {
+		checksum  string
+		appliedAt time.Time
+	}
+	applied := make(map[string]appliedRow)
+	var versions []string
+	for rows.Next() {
+		var version string
+		var row appliedRow
+		if err := rows.Scan(&version, &row.checksum, &row.appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = row
+		versions = append(versions, version)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(migrations))
+	status := make([]MigrationStatus, len(migrations))
+	for i, m := range migrations {
+		known[m.Version] = true
+		status[i].Migration = m
+		row, ok := applied[m.Version]
+		if !ok {
+			continue
+		}
+		if row.checksum != m.Checksum {
+			return nil, fmt.Errorf("migration %s_%s was edited after it was applied (checksum %s, applied %s)",
+				m.Version, m.Name, m.Checksum, row.checksum)
+		}
+		status[i].AppliedAt = &row.appliedAt
+	}
+	for _, version := range versions {
+		if !known[version] {
+			return nil, fmt.Errorf("database has migration %s which this build does not know, it was migrated by a newer version", version)
+		}
+	}
+	return status, nil
+}
+
+func pending(status []MigrationStatus) []Migration {
+	var migrations []Migration
+	for _, s := range status {
+		if s.AppliedAt == nil {
+			migrations = append(migrations, s.Migration)
+		}
+	}
+	return migrations
+}
+
+// withMigrationLock runs fn on a single connection holding the migration
+// lock, after making sure the migrations table exists
+func (db *DB) withMigrationLock(ctx context.Context, fn func(conn *stdsql.Conn) error) error {
+	conn, err := db.drv.DB().Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLock); err != nil {
+		return fmt.Errorf("failed to take migration lock: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLock)
+
+	if err := createMigrationsTable(ctx, conn); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+func inTx(ctx context.Context, conn *stdsql.Conn, fn func(tx *stdsql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
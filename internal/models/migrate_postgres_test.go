@@ -0,0 +1,98 @@
+//go:build postgres
+
+package models
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"gin-crud/ent"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// openTestDB connects to the database in GIN_CRUD_TEST_DSN. It must be an
+// empty, disposable database: the test migrates it up and back down.
+//
+//	GIN_CRUD_TEST_DSN="postgres://localhost/gin_crud_test?sslmode=disable" go test -tags postgres ./internal/models
+func openTestDB(t *testing.T) *DB {
+	t.Helper()
+	dsn := os.Getenv("GIN_CRUD_TEST_DSN")
+	if dsn == "" {
+		t.Skip("GIN_CRUD_TEST_DSN is not set")
+	}
+	drv, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := &DB{Client: ent.NewClient(ent.Driver(drv)), drv: drv}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestMigrateUpAndDown(t *testing.T) {
+	db := openTestDB(t)
+	ctx := t.Context()
+
+	migrations, err := Migrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := db.Pending(ctx); err != nil || len(got) != len(migrations) {
+		t.Fatalf("Pending = %d, %v; want %d on an empty database", len(got), err, len(migrations))
+	}
+	if err := db.CheckSchema(ctx); !errors.Is(err, ErrSchemaBehind) {
+		t.Fatalf("CheckSchema = %v, want ErrSchemaBehind", err)
+	}
+
+	applied, err := db.Migrate(ctx)
+	if err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+	t.Cleanup(func() { db.Rollback(context.Background(), len(migrations)) })
+	if len(applied) != len(migrations) {
+		t.Fatalf("Migrate applied %d migrations, want %d", len(applied), len(migrations))
+	}
+	if err := db.CheckSchema(ctx); err != nil {
+		t.Fatalf("CheckSchema after Migrate: %v", err)
+	}
+	if applied, err := db.Migrate(ctx); err != nil || len(applied) != 0 {
+		t.Fatalf("second Migrate = %d, %v; want nothing to apply", len(applied), err)
+	}
+
+	status, err := db.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range status {
+		if s.AppliedAt == nil {
+			t.Errorf("migration %s not recorded as applied", s.Version)
+		}
+	}
+
+	last := migrations[len(migrations)-1]
+	reverted, err := db.Rollback(ctx, 1)
+	if err != nil {
+		t.Fatalf("Rollback: %v", err)
+	}
+	if len(reverted) != 1 || reverted[0].Version != last.Version {
+		t.Fatalf("Rollback reverted %v, want %s", reverted, last.Version)
+	}
+	pending, err := db.Pending(ctx)
+	if err != nil || len(pending) != 1 || pending[0].Version != last.Version {
+		t.Fatalf("Pending after Rollback = %v, %v; want %s", pending, err, last.Version)
+	}
+
+	// The down SQL must leave the schema the up SQL can be replayed on
+	if applied, err := db.Migrate(ctx); err != nil || len(applied) != 1 {
+		t.Fatalf("Migrate after Rollback = %d, %v; want 1", len(applied), err)
+	}
+	if reverted, err := db.Rollback(ctx, len(migrations)); err != nil || len(reverted) != len(migrations) {
+		t.Fatalf("full Rollback = %d, %v; want %d", len(reverted), err, len(migrations))
+	}
+	if _, err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Migrate after full Rollback: %v", err)
+	}
+}
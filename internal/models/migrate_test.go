@@ -0,0 +1,195 @@
+package models
+
+import (
+	"database/sql"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestMigrations(t *testing.T) {
+	migrations, err := Migrations()
+	if err != nil {
+		t.Fatalf("Migrations: %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("no migrations embedded")
+	}
+	for i, m := range migrations {
+		if i > 0 && m.Version <= migrations[i-1].Version {
+			t.Errorf("migration %s is not ordered after %s", m.Version, migrations[i-1].Version)
+		}
+		if m.Name == "" || strings.TrimSpace(m.Up) == "" || strings.TrimSpace(m.Down) == "" {
+			t.Errorf("migration %s is missing its name, up or down SQL", m.Version)
+		}
+		if m.Checksum != migrationChecksum([]byte(m.Up), []byte(m.Down)) {
+			t.Errorf("migration %s checksum does not cover its SQL", m.Version)
+		}
+	}
+}
+
+func TestMigrationChecksumCoversDown(t *testing.T) {
+	up := []byte("CREATE TABLE t (id int);")
+	if migrationChecksum(up, []byte("DROP TABLE t;")) == migrationChecksum(up, []byte("")) {
+		t.Error("checksum does not change with the down SQL")
+	}
+	// The separator keeps SQL moved between up and down from hashing the same
+	if migrationChecksum([]byte("ab"), []byte("c")) == migrationChecksum([]byte("a"), []byte("bc")) {
+		t.Error("checksum does not separate up and down")
+	}
+}
+
+// migrationDir copies the embedded migrations into a map the test can tamper with
+func migrationDir(t *testing.T) fstest.MapFS {
+	t.Helper()
+	dir, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := fstest.MapFS{}
+	err = fs.WalkDir(dir, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := fs.ReadFile(dir, path)
+		files[path] = &fstest.MapFile{Data: data}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func TestVerifySum(t *testing.T) {
+	if err := verifySum(migrationDir(t)); err != nil {
+		t.Fatalf("embedded migrations do not match atlas.sum: %v", err)
+	}
+
+	t.Run("edited file", func(t *testing.T) {
+		dir := migrationDir(t)
+		names, _ := fs.Glob(dir, "*.up.sql")
+		file := dir[names[0]]
+		file.Data = append(append([]byte{}, file.Data...), "\n-- edited"...)
+		if err := verifySum(dir); err == nil {
+			t.Error("edited migration passed verification")
+		}
+	})
+
+	t.Run("added file", func(t *testing.T) {
+		dir := migrationDir(t)
+		dir["29990101000000_extra.up.sql"] = &fstest.MapFile{Data: []byte("SELECT 1;")}
+		if err := verifySum(dir); err == nil {
+			t.Error("unlisted migration passed verification")
+		}
+	})
+
+	t.Run("missing sum", func(t *testing.T) {
+		dir := migrationDir(t)
+		delete(dir, "atlas.sum")
+		if err := verifySum(dir); err == nil {
+			t.Error("missing atlas.sum passed verification")
+		}
+	})
+}
+
+// openMigrationsTable returns a database with an empty migrations table.
+// migrationStatus only reads the table, so SQLite stands in for PostgreSQL.
+func openMigrationsTable(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	_, err = db.Exec(`CREATE TABLE ` + migrationsTable + ` (
+		version varchar PRIMARY KEY,
+		name varchar NOT NULL,
+		checksum varchar NOT NULL,
+		applied_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func recordMigration(t *testing.T, db *sql.DB, version, checksum string) {
+	t.Helper()
+	_, err := db.Exec(`INSERT INTO `+migrationsTable+` (version, name, checksum) VALUES (?, ?, ?)`, version, "test", checksum)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrationStatus(t *testing.T) {
+	migrations, err := Migrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) < 2 {
+		t.Skip("needs at least two migrations")
+	}
+
+	t.Run("fresh database", func(t *testing.T) {
+		db := openMigrationsTable(t)
+		status, err := migrationStatus(t.Context(), db, migrations)
+		if err != nil {
+			t.Fatalf("migrationStatus: %v", err)
+		}
+		if got := pending(status); len(got) != len(migrations) {
+			t.Errorf("pending = %d migrations, want %d", len(got), len(migrations))
+		}
+	})
+
+	t.Run("partly applied", func(t *testing.T) {
+		db := openMigrationsTable(t)
+		recordMigration(t, db, migrations[0].Version, migrations[0].Checksum)
+		status, err := migrationStatus(t.Context(), db, migrations)
+		if err != nil {
+			t.Fatalf("migrationStatus: %v", err)
+		}
+		if status[0].AppliedAt == nil {
+			t.Errorf("migration %s not reported as applied", migrations[0].Version)
+		}
+		got := pending(status)
+		if len(got) != len(migrations)-1 || got[0].Version != migrations[1].Version {
+			t.Errorf("pending starts at %v, want %s", got, migrations[1].Version)
+		}
+	})
+
+	t.Run("fully applied", func(t *testing.T) {
+		db := openMigrationsTable(t)
+		for _, m := range migrations {
+			recordMigration(t, db, m.Version, m.Checksum)
+		}
+		status, err := migrationStatus(t.Context(), db, migrations)
+		if err != nil {
+			t.Fatalf("migrationStatus: %v", err)
+		}
+		if got := pending(status); len(got) != 0 {
+			t.Errorf("pending = %d migrations, want none", len(got))
+		}
+	})
+
+	t.Run("edited after applying", func(t *testing.T) {
+		db := openMigrationsTable(t)
+		recordMigration(t, db, migrations[0].Version, "stale")
+		_, err := migrationStatus(t.Context(), db, migrations)
+		if err == nil || !strings.Contains(err.Error(), "edited") {
+			t.Errorf("err = %v, want an edited migration error", err)
+		}
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		db := openMigrationsTable(t)
+		recordMigration(t, db, "29990101000000", "x")
+		_, err := migrationStatus(t.Context(), db, migrations)
+		if err == nil || !strings.Contains(err.Error(), "newer version") {
+			t.Errorf("err = %v, want an unknown migration error", err)
+		}
+	})
+}
@@ -0,0 +1,8 @@
+-- reverse: create index "users_email_key" to table: "users"
+DROP INDEX "users_email_key";
+-- reverse: create index "users_username_key" to table: "users"
+DROP INDEX "users_username_key";
+-- reverse: create "users" table
+DROP TABLE "users";
+-- reverse: create "items" table
+DROP TABLE "items";
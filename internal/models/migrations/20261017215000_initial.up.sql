@@ -0,0 +1,11 @@
+-- The schema of the first release, which ran ent's Schema.Create on startup.
+-- Databases created by it are baselined at this version and upgraded by the
+-- migrations that follow: gin-crud migrate baseline -version 20261017215000
+-- create "items" table
+CREATE TABLE "items" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "price" bigint NOT NULL, "description" character varying NULL, PRIMARY KEY ("id"));
+-- create "users" table
+CREATE TABLE "users" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "username" character varying NOT NULL, "password" character varying NOT NULL, "email" character varying NULL, PRIMARY KEY ("id"));
+-- create index "users_username_key" to table: "users"
+CREATE UNIQUE INDEX "users_username_key" ON "users" ("username");
+-- create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX "users_email_key" ON "users" ("email");
@@ -0,0 +1,48 @@
+-- reverse: create "tag_items" table
+DROP TABLE "tag_items";
+-- reverse: create index "tags_name_key" to table: "tags"
+DROP INDEX "tags_name_key";
+-- reverse: create "tags" table
+DROP TABLE "tags";
+-- reverse: create index "refreshtoken_family_id" to table: "refresh_tokens"
+DROP INDEX "refreshtoken_family_id";
+-- reverse: create index "refresh_tokens_token_hash_key" to table: "refresh_tokens"
+DROP INDEX "refresh_tokens_token_hash_key";
+-- reverse: create "refresh_tokens" table
+DROP TABLE "refresh_tokens";
+-- reverse: create index "pricechange_item_id" to table: "price_changes"
+DROP INDEX "pricechange_item_id";
+-- reverse: create index "pricechange_status_effective_from" to table: "price_changes"
+DROP INDEX "pricechange_status_effective_from";
+-- reverse: create "price_changes" table
+DROP TABLE "price_changes";
+-- reverse: create index "itemrevision_item_id_revision" to table: "item_revisions"
+DROP INDEX "itemrevision_item_id_revision";
+-- reverse: create "item_revisions" table
+DROP TABLE "item_revisions";
+-- reverse: create "item_images" table
+DROP TABLE "item_images";
+-- reverse: create "category_items" table
+DROP TABLE "category_items";
+-- reverse: convert prices to minor units
+UPDATE "items" SET "price" = "price" / 100;
+-- reverse: modify "items" table
+ALTER TABLE "items" DROP CONSTRAINT "items_users_items", DROP COLUMN "owner_id", DROP COLUMN "version", DROP COLUMN "stock", DROP COLUMN "currency", DROP COLUMN "deleted_at";
+-- reverse: modify "users" table
+ALTER TABLE "users" DROP COLUMN "role";
+-- reverse: create index "revoked_tokens_jti_key" to table: "revoked_tokens"
+DROP INDEX "revoked_tokens_jti_key";
+-- reverse: create "revoked_tokens" table
+DROP TABLE "revoked_tokens";
+-- reverse: create index "categories_slug_key" to table: "categories"
+DROP INDEX "categories_slug_key";
+-- reverse: create "categories" table
+DROP TABLE "categories";
+-- reverse: create index "auditentry_created_at" to table: "audit_entries"
+DROP INDEX "auditentry_created_at";
+-- reverse: create index "auditentry_actor_id" to table: "audit_entries"
+DROP INDEX "auditentry_actor_id";
+-- reverse: create index "auditentry_entity_type_entity_id" to table: "audit_entries"
+DROP INDEX "auditentry_entity_type_entity_id";
+-- reverse: create "audit_entries" table
+DROP TABLE "audit_entries";
@@ -0,0 +1,49 @@
+-- create "audit_entries" table
+CREATE TABLE "audit_entries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "actor_id" bigint NULL, "entity_type" character varying NOT NULL, "entity_id" bigint NOT NULL, "operation" character varying NOT NULL, "before" jsonb NULL, "after" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "auditentry_entity_type_entity_id" to table: "audit_entries"
+CREATE INDEX "auditentry_entity_type_entity_id" ON "audit_entries" ("entity_type", "entity_id");
+-- create index "auditentry_actor_id" to table: "audit_entries"
+CREATE INDEX "auditentry_actor_id" ON "audit_entries" ("actor_id");
+-- create index "auditentry_created_at" to table: "audit_entries"
+CREATE INDEX "auditentry_created_at" ON "audit_entries" ("created_at");
+-- create "categories" table
+CREATE TABLE "categories" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "slug" character varying NOT NULL, "parent_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "categories_categories_children" FOREIGN KEY ("parent_id") REFERENCES "categories" ("id") ON DELETE SET NULL);
+-- create index "categories_slug_key" to table: "categories"
+CREATE UNIQUE INDEX "categories_slug_key" ON "categories" ("slug");
+-- create "revoked_tokens" table
+CREATE TABLE "revoked_tokens" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "jti" character varying NOT NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "revoked_tokens_jti_key" to table: "revoked_tokens"
+CREATE UNIQUE INDEX "revoked_tokens_jti_key" ON "revoked_tokens" ("jti");
+-- modify "users" table
+ALTER TABLE "users" ADD COLUMN "role" character varying NOT NULL DEFAULT 'editor';
+-- modify "items" table
+ALTER TABLE "items" ADD COLUMN "deleted_at" timestamptz NULL, ADD COLUMN "currency" character varying NOT NULL DEFAULT 'USD', ADD COLUMN "stock" bigint NOT NULL DEFAULT 0, ADD COLUMN "version" bigint NOT NULL DEFAULT 1, ADD COLUMN "owner_id" bigint NULL, ADD CONSTRAINT "items_users_items" FOREIGN KEY ("owner_id") REFERENCES "users" ("id") ON DELETE SET NULL;
+-- prices were whole units before currencies were added and are minor units
+-- (cents of USD) now
+UPDATE "items" SET "price" = "price" * 100;
+-- create "category_items" table
+CREATE TABLE "category_items" ("category_id" bigint NOT NULL, "item_id" bigint NOT NULL, PRIMARY KEY ("category_id", "item_id"), CONSTRAINT "category_items_category_id" FOREIGN KEY ("category_id") REFERENCES "categories" ("id") ON DELETE CASCADE, CONSTRAINT "category_items_item_id" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON DELETE CASCADE);
+-- create "item_images" table
+CREATE TABLE "item_images" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "key" character varying NOT NULL, "thumbnail_key" character varying NOT NULL, "url" character varying NOT NULL, "thumbnail_url" character varying NOT NULL, "content_type" character varying NOT NULL, "size" bigint NOT NULL, "width" bigint NOT NULL, "height" bigint NOT NULL, "created_at" timestamptz NOT NULL, "item_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "item_images_items_images" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON DELETE CASCADE);
+-- create "item_revisions" table
+CREATE TABLE "item_revisions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "revision" bigint NOT NULL, "name" character varying NOT NULL, "price" bigint NOT NULL, "currency" character varying NOT NULL DEFAULT 'USD', "description" character varying NULL, "stock" bigint NOT NULL, "actor_id" bigint NULL, "created_at" timestamptz NOT NULL, "item_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "item_revisions_items_revisions" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON DELETE CASCADE);
+-- create index "itemrevision_item_id_revision" to table: "item_revisions"
+CREATE UNIQUE INDEX "itemrevision_item_id_revision" ON "item_revisions" ("item_id", "revision");
+-- create "price_changes" table
+CREATE TABLE "price_changes" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "price" bigint NOT NULL, "effective_from" timestamptz NOT NULL, "effective_to" timestamptz NULL, "status" character varying NOT NULL DEFAULT 'scheduled', "previous_price" bigint NULL, "applied_at" timestamptz NULL, "ended_at" timestamptz NULL, "created_by" bigint NULL, "created_at" timestamptz NOT NULL, "item_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "price_changes_items_price_changes" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON DELETE CASCADE);
+-- create index "pricechange_status_effective_from" to table: "price_changes"
+CREATE INDEX "pricechange_status_effective_from" ON "price_changes" ("status", "effective_from");
+-- create index "pricechange_item_id" to table: "price_changes"
+CREATE INDEX "pricechange_item_id" ON "price_changes" ("item_id");
+-- create "refresh_tokens" table
+CREATE TABLE "refresh_tokens" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "token_hash" character varying NOT NULL, "family_id" character varying NOT NULL, "access_jti" character varying NOT NULL, "expires_at" timestamptz NOT NULL, "revoked_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "user_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "refresh_tokens_users_refresh_tokens" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "refresh_tokens_token_hash_key" to table: "refresh_tokens"
+CREATE UNIQUE INDEX "refresh_tokens_token_hash_key" ON "refresh_tokens" ("token_hash");
+-- create index "refreshtoken_family_id" to table: "refresh_tokens"
+CREATE INDEX "refreshtoken_family_id" ON "refresh_tokens" ("family_id");
+-- create "tags" table
+CREATE TABLE "tags" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, PRIMARY KEY ("id"));
+-- create index "tags_name_key" to table: "tags"
+CREATE UNIQUE INDEX "tags_name_key" ON "tags" ("name");
+-- create "tag_items" table
+CREATE TABLE "tag_items" ("tag_id" bigint NOT NULL, "item_id" bigint NOT NULL, PRIMARY KEY ("tag_id", "item_id"), CONSTRAINT "tag_items_tag_id" FOREIGN KEY ("tag_id") REFERENCES "tags" ("id") ON DELETE CASCADE, CONSTRAINT "tag_items_item_id" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON DELETE CASCADE);
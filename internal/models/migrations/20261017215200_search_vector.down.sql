@@ -0,0 +1,4 @@
+-- reverse: create index "items_search_vector_idx" to table: "items"
+DROP INDEX "items_search_vector_idx";
+-- reverse: add column "search_vector" to table: "items"
+ALTER TABLE "items" DROP COLUMN "search_vector";
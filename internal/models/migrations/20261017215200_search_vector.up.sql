@@ -0,0 +1,10 @@
+-- ent cannot describe generated columns, so the full-text search column is
+-- written by hand. PostgreSQL keeps it in sync with name and description on
+-- every write; names weigh more than descriptions.
+-- add column "search_vector" to table: "items"
+ALTER TABLE "items" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('english', coalesce("name", '')), 'A') ||
+  setweight(to_tsvector('english', coalesce("description", '')), 'B')
+) STORED;
+-- create index "items_search_vector_idx" to table: "items"
+CREATE INDEX "items_search_vector_idx" ON "items" USING GIN ("search_vector");
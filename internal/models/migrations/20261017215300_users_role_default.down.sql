@@ -0,0 +1,2 @@
+-- reverse: modify "users" table
+ALTER TABLE "users" ALTER COLUMN "role" SET DEFAULT 'editor';
@@ -0,0 +1,3 @@
+-- Self-registered users start as viewers. Existing users keep their role.
+-- modify "users" table
+ALTER TABLE "users" ALTER COLUMN "role" SET DEFAULT 'viewer';
@@ -0,0 +1,228 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gin-crud/ent"
+	"gin-crud/ent/category"
+	"gin-crud/ent/item"
+	"gin-crud/ent/tag"
+	"gin-crud/internal/money"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Fixture is a set of records to seed, read from a YAML or JSON file:
+//
+//	categories:
+//	  - {name: Hardware, slug: hardware}
+//	  - {name: Keyboards, slug: keyboards, parent: hardware}
+//	tags: [sale, new]
+//	items:
+//	  - name: Keyboard
+//	    price: 19900
+//	    currency: USD
+//	    stock: 10
+//	    categories: [keyboards]
+//	    tags: [new]
+type Fixture struct {
+	Categories []FixtureCategory `json:"categories" yaml:"categories"`
+	Tags       []string          `json:"tags" yaml:"tags"`
+	Items      []FixtureItem     `json:"items" yaml:"items"`
+}
+
+// FixtureCategory is a category, placed under the category with the Parent slug
+type FixtureCategory struct {
+	Name   string `json:"name" yaml:"name"`
+	Slug   string `json:"slug" yaml:"slug"`
+	Parent string `json:"parent" yaml:"parent"`
+}
+
+// FixtureItem is an item. Categories are referenced by slug and tags by name.
+type FixtureItem struct {
+	Name        string   `json:"name" yaml:"name"`
+	Price       int      `json:"price" yaml:"price"`
+	Currency    string   `json:"currency" yaml:"currency"`
+	Description string   `json:"description" yaml:"description"`
+	Stock       int      `json:"stock" yaml:"stock"`
+	Categories  []string `json:"categories" yaml:"categories"`
+	Tags        []string `json:"tags" yaml:"tags"`
+}
+
+// SeedResult counts the records a seed created
+type SeedResult struct {
+	Categories int
+	Tags       int
+	Items      int
+}
+
+// DemoFixture returns the demo items the server used to seed on startup.
+// Prices are in cents of the default currency.
+func DemoFixture() *Fixture {
+	return &Fixture{
+		Items: []FixtureItem{
+			{Name: "Keyboard", Price: 19900, Description: "A mechanical keyboard", Stock: 10},
+			{Name: "Screen", Price: 29900, Description: "A 24-inch monitor", Stock: 5},
+			{Name: "Server", Price: 59900, Description: "A high-performance server", Stock: 2},
+			{Name: "Printer", Price: 39900, Description: "A color laser printer", Stock: 8},
+		},
+	}
+}
+
+// LoadFixture reads a fixture file, choosing YAML or JSON by extension
+func LoadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f Fixture
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		err = dec.Decode(&f)
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&f)
+	default:
+		return nil, fmt.Errorf("%s: unsupported fixture type %q, use .yaml, .yml or .json", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &f, nil
+}
+
+// Seed inserts a fixture in a single transaction. Categories are matched by
+// slug, tags by name and items by name, so records that already exist are
+// left alone and seeding the same fixture twice adds nothing.
+func (db *DB) Seed(ctx context.Context, f *Fixture) (SeedResult, error) {
+	var res SeedResult
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return res, err
+	}
+	if err := seed(ctx, tx.Client(), f, &res); err != nil {
+		tx.Rollback()
+		return SeedResult{}, err
+	}
+	return res, tx.Commit()
+}
+
+func seed(ctx context.Context, client *ent.Client, f *Fixture, res *SeedResult) error {
+	categoryIDs := make(map[string]int)
+	for _, fc := range f.Categories {
+		existing, err := client.Category.Query().Where(category.Slug(fc.Slug)).Only(ctx)
+		if err == nil {
+			categoryIDs[fc.Slug] = existing.ID
+			continue
+		}
+		if !ent.IsNotFound(err) {
+			return err
+		}
+
+		create := client.Category.Create().SetName(fc.Name).SetSlug(fc.Slug)
+		if fc.Parent != "" {
+			parentID, err := lookupCategory(ctx, client, categoryIDs, fc.Parent)
+			if err != nil {
+				return fmt.Errorf("category %s: %w", fc.Slug, err)
+			}
+			create.SetParentID(parentID)
+		}
+		created, err := create.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("category %s: %w", fc.Slug, err)
+		}
+		categoryIDs[fc.Slug] = created.ID
+		res.Categories++
+	}
+
+	tagIDs := make(map[string]int)
+	ensureTag := func(name string) (int, error) {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if id, ok := tagIDs[name]; ok {
+			return id, nil
+		}
+		existing, err := client.Tag.Query().Where(tag.Name(name)).Only(ctx)
+		if err == nil {
+			tagIDs[name] = existing.ID
+			return existing.ID, nil
+		}
+		if !ent.IsNotFound(err) {
+			return 0, err
+		}
+		created, err := client.Tag.Create().SetName(name).Save(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("tag %s: %w", name, err)
+		}
+		tagIDs[name] = created.ID
+		res.Tags++
+		return created.ID, nil
+	}
+	for _, name := range f.Tags {
+		if _, err := ensureTag(name); err != nil {
+			return err
+		}
+	}
+
+	for _, fi := range f.Items {
+		exists, err := client.Item.Query().Where(item.Name(fi.Name)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		currency := fi.Currency
+		if currency == "" {
+			currency = money.DefaultCurrency
+		}
+		create := client.Item.
+			Create().
+			SetName(fi.Name).
+			SetPrice(fi.Price).
+			SetCurrency(currency).
+			SetDescription(fi.Description).
+			SetStock(fi.Stock)
+		for _, slug := range fi.Categories {
+			id, err := lookupCategory(ctx, client, categoryIDs, slug)
+			if err != nil {
+				return fmt.Errorf("item %s: %w", fi.Name, err)
+			}
+			create.AddCategoryIDs(id)
+		}
+		for _, name := range fi.Tags {
+			id, err := ensureTag(name)
+			if err != nil {
+				return err
+			}
+			create.AddTagIDs(id)
+		}
+		if _, err := create.Save(ctx); err != nil {
+			return fmt.Errorf("item %s: %w", fi.Name, err)
+		}
+		res.Items++
+	}
+	return nil
+}
+
+// lookupCategory resolves a slug from the fixture or the database
+func lookupCategory(ctx context.Context, client *ent.Client, known map[string]int, slug string) (int, error) {
+	if id, ok := known[slug]; ok {
+		return id, nil
+	}
+	id, err := client.Category.Query().Where(category.Slug(slug)).OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		return 0, fmt.Errorf("unknown category %q", slug)
+	}
+	return id, err
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"gin-crud/ent"
+	"gin-crud/ent/user"
+	"gin-crud/internal/sessions"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// CreateUser creates a user with a bcrypt-hashed password. An empty email is not stored.
+func (db *DB) CreateUser(ctx context.Context, username, email, password string, role user.Role) (*ent.User, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// The audit hook records the user in the same transaction
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	create := tx.User.
+		Create().
+		SetUsername(username).
+		SetPassword(string(hashed)).
+		SetRole(role)
+	if email != "" {
+		create.SetEmail(email)
+	}
+	u, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return u.Unwrap(), tx.Commit()
+}
+
+// ResetPassword sets a new password for a user and signs them out of every session
+func (db *DB) ResetPassword(ctx context.Context, username, password string) error {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	u, err := tx.User.Query().Where(user.Username(username)).Only(ctx)
+	if err != nil {
+		return err
+	}
+	if err := tx.User.UpdateOne(u).SetPassword(string(hashed)).Exec(ctx); err != nil {
+		return err
+	}
+	if err := sessions.RevokeUser(ctx, tx.Client(), u.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SetRole grants a user a new role and signs them out of every session, since
+// issued tokens still carry the old role
+func (db *DB) SetRole(ctx context.Context, username string, role user.Role) error {
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	u, err := tx.User.Query().Where(user.Username(username)).Only(ctx)
+	if err != nil {
+		return err
+	}
+	if err := tx.User.UpdateOne(u).SetRole(role).Exec(ctx); err != nil {
+		return err
+	}
+	if err := sessions.RevokeUser(ctx, tx.Client(), u.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,68 @@
+package money
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is used for prices given without a currency
+const DefaultCurrency = "USD"
+
+// currencies maps the supported ISO 4217 codes to the number of digits of
+// their minor unit, e.g. 2 for cents
+var currencies = map[string]int{
+	"AED": 2, "ARS": 2, "AUD": 2, "BGN": 2, "BHD": 3, "BRL": 2,
+	"CAD": 2, "CHF": 2, "CLP": 0, "CNY": 2, "COP": 2, "CZK": 2,
+	"DKK": 2, "EGP": 2, "EUR": 2, "GBP": 2, "HKD": 2, "HUF": 2,
+	"IDR": 2, "ILS": 2, "INR": 2, "ISK": 0, "JOD": 3, "JPY": 0,
+	"KRW": 0, "KWD": 3, "MXN": 2, "MYR": 2, "NGN": 2, "NOK": 2,
+	"NZD": 2, "OMR": 3, "PHP": 2, "PKR": 2, "PLN": 2, "QAR": 2,
+	"RON": 2, "SAR": 2, "SEK": 2, "SGD": 2, "THB": 2, "TND": 3,
+	"TRY": 2, "TWD": 2, "UAH": 2, "USD": 2, "VND": 0, "ZAR": 2,
+}
+
+// Money is an amount in minor units together with its currency, as rendered in responses
+type Money struct {
+	Amount    int64  `json:"amount"`
+	Currency  string `json:"currency"`
+	Formatted string `json:"formatted"`
+}
+
+// New returns the Money value for an amount in minor units
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency, Formatted: Format(amount, currency)}
+}
+
+// Exponent returns the number of minor unit digits of a currency
+func Exponent(currency string) (int, bool) {
+	exp, ok := currencies[currency]
+	return exp, ok
+}
+
+// ValidateCurrency checks that code is a supported, upper-case ISO 4217 code
+func ValidateCurrency(code string) error {
+	if _, ok := currencies[code]; !ok {
+		return fmt.Errorf("unsupported currency %q", code)
+	}
+	return nil
+}
+
+// Format renders an amount in minor units as a decimal string in the major
+// unit, e.g. 1999 USD is "19.99" and 1999 JPY is "1999"
+func Format(amount int64, currency string) string {
+	exp, ok := currencies[currency]
+	if !ok || exp == 0 {
+		return strconv.FormatInt(amount, 10)
+	}
+
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	digits := strconv.FormatInt(amount, 10)
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
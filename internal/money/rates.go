@@ -0,0 +1,112 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// ErrNoRate is returned when a conversion involves a currency missing from the table
+var ErrNoRate = errors.New("no exchange rate")
+
+// Rates holds how many units of each currency one unit of the base currency buys
+type Rates struct {
+	base  string
+	rates map[string]*big.Rat
+}
+
+// LoadRates reads the exchange rate table from a JSON file, e.g.
+//
+//	{"base": "USD", "rates": {"EUR": "0.92", "GBP": "0.79"}}
+//
+// Rates may be given as strings or numbers. Without a file only
+// same-currency conversions are possible.
+func LoadRates(path string) (*Rates, error) {
+	if path == "" {
+		return NewRates(), nil
+	}
+	return readRates(path)
+}
+
+// NewRates returns a table that only converts between equal currencies
+func NewRates() *Rates {
+	return &Rates{base: DefaultCurrency, rates: map[string]*big.Rat{DefaultCurrency: big.NewRat(1, 1)}}
+}
+
+func readRates(path string) (*Rates, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file struct {
+		Base  string                 `json:"base"`
+		Rates map[string]json.Number `json:"rates"`
+	}
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := ValidateCurrency(file.Base); err != nil {
+		return nil, fmt.Errorf("%s: base: %w", path, err)
+	}
+
+	r := &Rates{base: file.Base, rates: map[string]*big.Rat{file.Base: big.NewRat(1, 1)}}
+	for code, value := range file.Rates {
+		if err := ValidateCurrency(code); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		rate, ok := new(big.Rat).SetString(value.String())
+		if !ok || rate.Sign() <= 0 {
+			return nil, fmt.Errorf("%s: invalid rate %q for %s", path, value, code)
+		}
+		r.rates[code] = rate
+	}
+	return r, nil
+}
+
+// Convert converts an amount in minor units of one currency to minor units of
+// another, rounding half away from zero
+func (r *Rates) Convert(amount int64, from, to string) (int64, error) {
+	if from == to {
+		return amount, nil
+	}
+	fromRate, ok := r.rates[from]
+	if !ok {
+		return 0, fmt.Errorf("%w for %s", ErrNoRate, from)
+	}
+	toRate, ok := r.rates[to]
+	if !ok {
+		return 0, fmt.Errorf("%w for %s", ErrNoRate, to)
+	}
+	fromExp, _ := Exponent(from)
+	toExp, _ := Exponent(to)
+
+	// amount / 10^fromExp / fromRate * toRate * 10^toExp
+	v := new(big.Rat).SetInt64(amount)
+	v.Mul(v, toRate)
+	v.Quo(v, fromRate)
+	v.Mul(v, new(big.Rat).SetFrac(pow10(toExp), pow10(fromExp)))
+
+	return roundHalfAway(v), nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundHalfAway rounds a rational to the nearest integer, halves away from zero
+func roundHalfAway(v *big.Rat) int64 {
+	num := new(big.Int).Abs(v.Num())
+	den := v.Denom()
+
+	q, m := new(big.Int).QuoRem(num, den, new(big.Int))
+	if m.Mul(m, big.NewInt(2)).Cmp(den) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if v.Sign() < 0 {
+		q.Neg(q)
+	}
+	return q.Int64()
+}
@@ -0,0 +1,73 @@
+package revisions
+
+import (
+	"context"
+
+	"gin-crud/ent"
+	"gin-crud/ent/hook"
+	"gin-crud/ent/item"
+	"gin-crud/ent/schema"
+	"gin-crud/internal/txhook"
+)
+
+// Register installs the hook that snapshots items into item_revisions
+// whenever they are created or their version is bumped
+func Register(client *ent.Client) {
+	client.Item.Use(
+		hook.On(snapshotHook, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+	)
+}
+
+func snapshotHook(next ent.Mutator) ent.Mutator {
+	return hook.ItemFunc(func(ctx context.Context, m *ent.ItemMutation) (ent.Value, error) {
+		// Writes that leave the version alone, such as soft deletes, are not new revisions
+		if _, bumped := m.AddedVersion(); !m.Op().Is(ent.OpCreate) && !bumped {
+			return next.Mutate(ctx, m)
+		}
+
+		var ids []int64
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = m.IDs(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		// Within the write's transaction the rows still hold the version it
+		// set, since a concurrent write waits for the row lock
+		client := txhook.Client(ctx, m)
+		var rows []*ent.Item
+		if created, ok := v.(*ent.Item); ok {
+			rows = []*ent.Item{created}
+		} else if rows, err = client.Item.Query().Where(item.IDIn(ids...)).All(schema.SkipSoftDelete(ctx)); err != nil {
+			return nil, err
+		}
+
+		actorID, _ := ctx.Value("userID").(int)
+		builders := make([]*ent.ItemRevisionCreate, 0, len(rows))
+		for _, row := range rows {
+			create := client.ItemRevision.
+				Create().
+				SetItemID(row.ID).
+				SetRevision(row.Version).
+				SetName(row.Name).
+				SetPrice(row.Price).
+				SetCurrency(row.Currency).
+				SetDescription(row.Description).
+				SetStock(row.Stock)
+			if actorID != 0 {
+				create.SetActorID(actorID)
+			}
+			builders = append(builders, create)
+		}
+		if len(builders) == 0 {
+			return v, nil
+		}
+		return v, client.ItemRevision.CreateBulk(builders...).Exec(ctx)
+	})
+}
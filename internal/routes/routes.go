@@ -1,24 +1,95 @@
 package routes
 
 import (
+	"gin-crud/internal/app"
 	"gin-crud/internal/handlers"
 	"gin-crud/internal/middleware"
+	"gin-crud/internal/storage"
     "github.com/gin-gonic/gin"
 )
 
-func SetupRoutes(router *gin.Engine) {
+// SetupRoutes registers every endpoint on router, served with the dependencies of
+// the App a. Tests can pass an App built around an enttest client.
+func SetupRoutes(router *gin.Engine, a *app.App) {
+    h := handlers.New(a)
+    requireAuth := middleware.JWTMiddleware(a)
+
     // Unprotected routes for authentication
-    router.POST("/register", handlers.RegisterUser)
-    router.POST("/login", handlers.LoginUser)
+    router.POST("/register", h.RegisterUser)
+    router.POST("/login", h.LoginUser)
+    router.POST("/token/refresh", h.RefreshToken)
+    router.POST("/logout", requireAuth, h.Logout)
+
+    // Public keys for services that verify our tokens
+    router.GET("/.well-known/jwks.json", h.JWKS)
+
+    // Uploaded images, when they are kept on the local disk
+    if local, ok := a.Storage.(*storage.Local); ok {
+        router.Static(storage.LocalMediaPath, local.Dir)
+    }
 
     // Protected routes for items (require JWT authentication)
     items := router.Group("/items")
-    items.Use(middleware.JWTMiddleware())
+    items.Use(requireAuth)
+    {
+        // Every role may read items
+        items.GET("", h.GetItems)
+        items.GET("export", h.ExportItems)
+        items.GET("search", h.SearchItems)
+        items.GET(":id", h.GetItem)
+        items.GET(":id/images", h.GetItemImages)
+        items.GET(":id/prices", h.GetItemPrices)
+        items.GET(":id/revisions", h.GetItemRevisions)
+        items.GET(":id/revisions/:rev", h.GetItemRevision)
+
+        // Only admins and editors may write, and only admins may delete
+        items.POST("", middleware.RequireRole("admin", "editor"), h.CreateItem)
+        items.POST("bulk", middleware.RequireRole("admin", "editor"), h.BulkCreateItems)
+        items.PATCH("bulk", middleware.RequireRole("admin", "editor"), h.BulkUpdateItems)
+        items.DELETE("bulk", middleware.RequireRole("admin"), h.BulkDeleteItems)
+        items.POST("import", middleware.RequireRole("admin", "editor"), h.ImportItems)
+        items.PUT(":id", middleware.RequireRole("admin", "editor"), h.UpdateItem)
+        items.PATCH(":id", middleware.RequireRole("admin", "editor"), h.PatchItem)
+        items.POST(":id/stock/adjust", middleware.RequireRole("admin", "editor"), h.AdjustStock)
+        items.POST(":id/revisions/:rev/restore", middleware.RequireRole("admin", "editor"), h.RestoreItemRevision)
+        items.PUT(":id/categories", middleware.RequireRole("admin", "editor"), h.SetItemCategories)
+        items.PUT(":id/tags", middleware.RequireRole("admin", "editor"), h.SetItemTags)
+        items.POST(":id/images", middleware.RequireRole("admin", "editor"), h.UploadItemImage)
+        items.DELETE(":id/images/:imageID", middleware.RequireRole("admin", "editor"), h.DeleteItemImage)
+        items.POST(":id/prices", middleware.RequireRole("admin", "editor"), h.SchedulePriceChange)
+        items.DELETE(":id/prices/:changeID", middleware.RequireRole("admin", "editor"), h.CancelPriceChange)
+        items.DELETE(":id", middleware.RequireRole("admin"), h.DeleteItem)
+        items.POST(":id/restore", middleware.RequireRole("admin"), h.RestoreItem)
+    }
+
+    // Categories and tags are read by everyone and managed by admins and editors
+    categories := router.Group("/categories")
+    categories.Use(requireAuth)
     {
-        items.GET("", handlers.GetItems)
-        items.GET(":id", handlers.GetItem)
-        items.POST("", handlers.CreateItem)
-        items.PUT(":id", handlers.UpdateItem)
-        items.DELETE(":id", handlers.DeleteItem)
+        categories.GET("", h.GetCategories)
+        categories.GET(":id", h.GetCategory)
+        categories.POST("", middleware.RequireRole("admin", "editor"), h.CreateCategory)
+        categories.PUT(":id", middleware.RequireRole("admin", "editor"), h.UpdateCategory)
+        categories.DELETE(":id", middleware.RequireRole("admin"), h.DeleteCategory)
     }
-}
\ No newline at end of file
+
+    tags := router.Group("/tags")
+    tags.Use(requireAuth)
+    {
+        tags.GET("", h.GetTags)
+        tags.POST("", middleware.RequireRole("admin", "editor"), h.CreateTag)
+        tags.PUT(":id", middleware.RequireRole("admin", "editor"), h.UpdateTag)
+        tags.DELETE(":id", middleware.RequireRole("admin"), h.DeleteTag)
+    }
+
+    // Protected routes for the authenticated user
+    users := router.Group("/users")
+    users.Use(requireAuth)
+    {
+        users.GET("me/items", h.GetMyItems)
+        users.PUT(":id/role", middleware.RequireRole("admin"), h.SetUserRole)
+    }
+
+    // Audit log, admins only
+    router.GET("/audit", requireAuth, middleware.RequireRole("admin"), h.GetAuditEntries)
+}
@@ -0,0 +1,111 @@
+package routes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gin-crud/ent/enttest"
+	"gin-crud/internal/app"
+	"gin-crud/internal/config"
+	"gin-crud/internal/keys"
+	"gin-crud/internal/models"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newTestRouter serves every route from an App backed by its own in-memory
+// SQLite database and an ephemeral signing key
+func newTestRouter(t *testing.T, name string) *gin.Engine {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", name))
+	t.Cleanup(func() { client.Close() })
+	models.RegisterHooks(client)
+
+	logger := log.New(io.Discard, "", 0)
+	keySet, err := keys.Load(config.JWTConfig{}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	SetupRoutes(router, &app.App{
+		Config: config.Default(),
+		Client: client,
+		Logger: logger,
+		Keys:   keySet,
+	})
+	return router
+}
+
+func do(router *gin.Engine, method, target, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// register signs up a user and returns their access token
+func register(t *testing.T, router *gin.Engine, username string) string {
+	t.Helper()
+	body := fmt.Sprintf(`{"username": %q, "email": "%s@example.com", "password": "secret123"}`, username, username)
+	w := do(router, http.MethodPost, "/register", "", body)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("register %s: status = %d, body %s", username, w.Code, w.Body)
+	}
+	var resp struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil || resp.Token == "" {
+		t.Fatalf("register %s: no token in %s", username, w.Body)
+	}
+	return resp.Token
+}
+
+func TestAppsInOneProcessAreIndependent(t *testing.T) {
+	first := newTestRouter(t, "first")
+	second := newTestRouter(t, "second")
+
+	// The same username is free in each app, since they share no database
+	token := register(t, first, "alice")
+	register(t, second, "alice")
+
+	// A token signed by one app is not accepted by the other
+	if w := do(first, http.MethodGet, "/items", token, ""); w.Code != http.StatusOK {
+		t.Errorf("own token: status = %d, body %s", w.Code, w.Body)
+	}
+	if w := do(second, http.MethodGet, "/items", token, ""); w.Code != http.StatusUnauthorized {
+		t.Errorf("other app's token: status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestRoutesEnforceRoles(t *testing.T) {
+	router := newTestRouter(t, t.Name())
+
+	if w := do(router, http.MethodGet, "/items", "", ""); w.Code != http.StatusUnauthorized {
+		t.Errorf("anonymous list: status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+
+	// New users are viewers, who may read but not write
+	token := register(t, router, "bob")
+	if w := do(router, http.MethodGet, "/items", token, ""); w.Code != http.StatusOK {
+		t.Errorf("viewer list: status = %d, body %s", w.Code, w.Body)
+	}
+	if w := do(router, http.MethodPost, "/items", token, `{"name": "Keyboard", "price": 1999}`); w.Code != http.StatusForbidden {
+		t.Errorf("viewer create: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+	if w := do(router, http.MethodGet, "/audit", token, ""); w.Code != http.StatusForbidden {
+		t.Errorf("viewer audit: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
@@ -0,0 +1,68 @@
+package sessions
+
+import (
+	"context"
+	"time"
+
+	"gin-crud/ent"
+	"gin-crud/ent/predicate"
+	"gin-crud/ent/refreshtoken"
+	"gin-crud/ent/revokedtoken"
+)
+
+// AccessTokenTTL is the lifetime of the JWT access token
+const AccessTokenTTL = 15 * time.Minute
+
+// RevokeFamily revokes every refresh token of a family and blocks the access
+// tokens that were issued alongside them
+func RevokeFamily(ctx context.Context, client *ent.Client, familyID string) error {
+	return revoke(ctx, client, refreshtoken.FamilyID(familyID))
+}
+
+// RevokeUser signs a user out everywhere by revoking all of their sessions
+func RevokeUser(ctx context.Context, client *ent.Client, userID int) error {
+	return revoke(ctx, client, refreshtoken.UserID(userID))
+}
+
+func revoke(ctx context.Context, client *ent.Client, where predicate.RefreshToken) error {
+	now := time.Now()
+	tokens, err := client.RefreshToken.
+		Query().
+		Where(where).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tokens {
+		// Access tokens issued more than AccessTokenTTL ago have expired on their own
+		if now.Sub(t.CreatedAt) < AccessTokenTTL {
+			if err := RevokeAccessToken(ctx, client, t.AccessJti, t.CreatedAt.Add(AccessTokenTTL)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return client.RefreshToken.
+		Update().
+		Where(where, refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Exec(ctx)
+}
+
+// RevokeAccessToken adds an access token jti to the revocation list
+func RevokeAccessToken(ctx context.Context, client *ent.Client, jti string, expiresAt time.Time) error {
+	exists, err := client.RevokedToken.
+		Query().
+		Where(revokedtoken.Jti(jti)).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	return client.RevokedToken.
+		Create().
+		SetJti(jti).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+}
@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// s3Request is what the stand-in server saw of a request
+type s3Request struct {
+	method, path, contentType, auth, body string
+}
+
+// newS3StandIn starts a server that records every request and answers with status
+func newS3StandIn(t *testing.T, status int) (*httptest.Server, *[]s3Request) {
+	t.Helper()
+	var seen []s3Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		seen = append(seen, s3Request{
+			method:      r.Method,
+			path:        r.URL.EscapedPath(),
+			contentType: r.Header.Get("Content-Type"),
+			auth:        r.Header.Get("Authorization"),
+			body:        string(body),
+		})
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &seen
+}
+
+func newTestS3(t *testing.T, endpoint string) *S3 {
+	t.Helper()
+	s, err := NewS3(S3Config{
+		Endpoint:        endpoint,
+		Region:          "eu-west-1",
+		Bucket:          "media",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestS3PutKeepsEndpointPath(t *testing.T) {
+	for _, tc := range []struct {
+		prefix, want string
+	}{
+		{"", "/media/items/1/a%20b.png"},
+		{"/s3", "/s3/media/items/1/a%20b.png"},
+		{"/s3/", "/s3/media/items/1/a%20b.png"},
+	} {
+		srv, seen := newS3StandIn(t, http.StatusOK)
+		s := newTestS3(t, srv.URL+tc.prefix)
+
+		if err := s.Put(t.Context(), "items/1/a b.png", []byte("png"), "image/png"); err != nil {
+			t.Fatalf("prefix %q: %v", tc.prefix, err)
+		}
+		if len(*seen) != 1 {
+			t.Fatalf("prefix %q: got %d requests, want 1", tc.prefix, len(*seen))
+		}
+		got := (*seen)[0]
+		if got.method != http.MethodPut || got.path != tc.want {
+			t.Errorf("prefix %q: request = %s %s, want PUT %s", tc.prefix, got.method, got.path, tc.want)
+		}
+		if got.body != "png" || got.contentType != "image/png" {
+			t.Errorf("prefix %q: body %q with type %q", tc.prefix, got.body, got.contentType)
+		}
+		if !strings.HasPrefix(got.auth, "AWS4-HMAC-SHA256 Credential=AKID/") || !strings.Contains(got.auth, "/eu-west-1/s3/aws4_request") {
+			t.Errorf("prefix %q: Authorization = %q", tc.prefix, got.auth)
+		}
+		if want := srv.URL + tc.want; s.URL("items/1/a b.png") != want {
+			t.Errorf("prefix %q: URL = %s, want %s", tc.prefix, s.URL("items/1/a b.png"), want)
+		}
+	}
+}
+
+func TestS3DeleteStatuses(t *testing.T) {
+	for _, tc := range []struct {
+		status  int
+		wantErr bool
+	}{
+		{http.StatusNoContent, false},
+		{http.StatusNotFound, false},
+		{http.StatusForbidden, true},
+	} {
+		srv, _ := newS3StandIn(t, tc.status)
+		err := newTestS3(t, srv.URL).Delete(t.Context(), "items/1/a.png")
+		if (err != nil) != tc.wantErr {
+			t.Errorf("status %d: err = %v, want error %v", tc.status, err, tc.wantErr)
+		}
+	}
+}
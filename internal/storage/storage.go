@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gin-crud/internal/config"
+)
+
+// Storage stores uploaded files under slash-separated keys
+type Storage interface {
+	// Put writes data under key, replacing any existing object
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Delete removes the object under key. Missing objects are not an error.
+	Delete(ctx context.Context, key string) error
+	// URL returns the address clients download the object from
+	URL(key string) string
+}
+
+// New returns the storage backend for uploads selected by cfg.Driver.
+// The s3 driver works with any S3-compatible service such as MinIO, using
+// path-style addressing.
+func New(cfg config.StorageConfig) (Storage, error) {
+	publicURL := strings.TrimSuffix(cfg.PublicURL, "/")
+
+	switch cfg.Driver {
+	case "", "local":
+		dir := cfg.LocalDir
+		if dir == "" {
+			dir = "uploads"
+		}
+		if publicURL == "" {
+			publicURL = LocalMediaPath
+		}
+		return NewLocal(dir, publicURL)
+	case "s3":
+		return NewS3(S3Config{
+			Endpoint:        cfg.S3.Endpoint,
+			Region:          cfg.S3.Region,
+			Bucket:          cfg.S3.Bucket,
+			AccessKeyID:     cfg.S3.AccessKeyID,
+			SecretAccessKey: cfg.S3.SecretAccessKey,
+			PublicURL:       publicURL,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+}
@@ -0,0 +1,77 @@
+package txhook
+
+import (
+	"context"
+	"fmt"
+
+	"gin-crud/ent"
+)
+
+// Register runs item and user updates and deletes that are not already part
+// of a transaction in one, so the audit entries and revisions other hooks
+// write alongside them commit or roll back together with the change, and
+// rows they read back cannot have been changed by a concurrent write.
+// Register it before those hooks so it wraps them.
+//
+// Creates are left alone: CreateBulk chains the hooks of its builders, so a
+// create cannot be dispatched again on its own. Callers that create items or
+// users outside a transaction must open one themselves.
+func Register(client *ent.Client) {
+	client.Item.Use(hook)
+	client.User.Use(hook)
+}
+
+// Client returns the client a hook should read and write with: the
+// transaction the mutation runs in, if any, otherwise the mutation's own
+func Client(ctx context.Context, m interface{ Client() *ent.Client }) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return m.Client()
+}
+
+// mutation is implemented by every generated mutation
+type mutation interface {
+	ent.Mutation
+	Client() *ent.Client
+	Tx() (*ent.Tx, error)
+}
+
+func hook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if m.Op().Is(ent.OpCreate) || ent.TxFromContext(ctx) != nil {
+			return next.Mutate(ctx, m)
+		}
+		mx, ok := m.(mutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		if _, err := mx.Tx(); err == nil {
+			return next.Mutate(ctx, m)
+		}
+
+		tx, err := mx.Client().Tx(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Dispatch the mutation again on the transaction; the hooks see it
+		// in ctx and pass it through
+		v, err := tx.Client().Mutate(ent.NewTxContext(ctx, tx), m)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+
+		// Entities returned by the transaction would keep using it for edge queries
+		switch e := v.(type) {
+		case *ent.Item:
+			v = e.Unwrap()
+		case *ent.User:
+			v = e.Unwrap()
+		}
+		return v, nil
+	})
+}
@@ -1,27 +1,81 @@
 package main
 
 import (
-	"gin-crud/internal/models"
-	"gin-crud/internal/routes"
+	"errors"
+	"flag"
+	"fmt"
+	"gin-crud/internal/config"
 	"log"
-
-	"github.com/gin-gonic/gin"
+	"os"
+	"strings"
 )
 
+// command is a subcommand of the binary
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+var commands = []command{
+	{"serve", "run the HTTP server (default)", runServe},
+	{"migrate", "run versioned migrations: up, down, status, dry-run or baseline", runMigrate},
+	{"seed", "insert records from a YAML or JSON fixture", runSeed},
+	{"create-admin", "create a user with the admin role", runCreateAdmin},
+	{"set-role", "grant a user the admin, editor or viewer role", runSetRole},
+	{"reset-password", "set a new password for a user and sign them out", runResetPassword},
+	{"list-users", "list users and their roles", runListUsers},
+}
+
 func main() {
-	// Initialize the database
-	models.InitDB()
-	defer models.CloseDB()
+	args := os.Args[1:]
 
-	// Set up the Gin router with default middleware
-	router := gin.Default()
+	// Without a subcommand the server starts, as it always has
+	name := "serve"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+	if name == "help" {
+		usage()
+		return
+	}
 
-	// Set up routes
-	routes.SetupRoutes(router)
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+		err := cmd.run(args)
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "unknown command %q\n\n", name)
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: gin-crud <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-15s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Run gin-crud <command> -h for the flags of a command.")
+}
 
-	// Start the server
-	log.Println("Server started at http://localhost:8080")
-	if err := router.Run(":8080"); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+// parseFlags parses args with the command's own flags, already registered on
+// flags, and the configuration flags, then loads the configuration
+func parseFlags(flags *flag.FlagSet, args []string) (*config.Config, error) {
+	loader := config.NewLoader(flags)
+	if err := flags.Parse(args); err != nil {
+		return nil, err
 	}
+	return loader.Load()
 }
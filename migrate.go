@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"gin-crud/internal/models"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// migrateActions are the actions of the migrate command
+var migrateActions = []string{"up", "down", "status", "dry-run", "baseline"}
+
+// runMigrate runs "migrate [up|down|status|dry-run|baseline]". up applies
+// the pending migrations, down reverts the latest ones, status lists every
+// migration, dry-run prints the SQL up would run and baseline marks an
+// existing unversioned database as migrated up to -version.
+func runMigrate(args []string) error {
+	action := "up"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		action, args = args[0], args[1:]
+	}
+	known := false
+	for _, a := range migrateActions {
+		known = known || a == action
+	}
+	if !known {
+		return fmt.Errorf("unknown migrate action %q, use %s", action, strings.Join(migrateActions, ", "))
+	}
+
+	flags := flag.NewFlagSet("gin-crud migrate "+action, flag.ContinueOnError)
+	var steps *int
+	var version *string
+	switch action {
+	case "down":
+		steps = flags.Int("steps", 1, "number of migrations to revert")
+	case "baseline":
+		version = flags.String("version", "", "latest migration the schema already matches (required); 20261017215000 for databases created by the first release")
+	}
+	cfg, err := parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+
+	db, err := models.Open(cfg.DB)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	switch action {
+	case "up":
+		applied, err := db.Migrate(ctx)
+		for _, m := range applied {
+			fmt.Printf("Applied %s_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Println("Schema is up to date")
+		}
+	case "down":
+		if *steps < 1 {
+			return errors.New("-steps must be at least 1")
+		}
+		reverted, err := db.Rollback(ctx, *steps)
+		for _, m := range reverted {
+			fmt.Printf("Reverted %s_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(reverted) == 0 {
+			fmt.Println("No migrations to revert")
+		}
+	case "status":
+		status, err := db.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+		for _, s := range status {
+			applied := "pending"
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Local().Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\n", s.Version, s.Name, applied)
+		}
+		return w.Flush()
+	case "dry-run":
+		pending, err := db.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			fmt.Println("-- Schema is up to date")
+		}
+		for _, m := range pending {
+			fmt.Printf("-- migration %s_%s\n%s\n", m.Version, m.Name, strings.TrimSpace(m.Up))
+		}
+	case "baseline":
+		if *version == "" {
+			return errors.New("-version is required, see \"gin-crud migrate status\" for the versions")
+		}
+		marked, err := db.Baseline(ctx, *version)
+		if err != nil {
+			return err
+		}
+		for _, m := range marked {
+			fmt.Printf("Marked %s_%s as applied\n", m.Version, m.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"gin-crud/internal/models"
+)
+
+// runSeed runs "seed [-file fixture.yaml]". Without a file the demo items are seeded.
+func runSeed(args []string) error {
+	flags := flag.NewFlagSet("gin-crud seed", flag.ContinueOnError)
+	file := flags.String("file", "", "YAML or JSON fixture to seed; without it the demo items are seeded")
+	cfg, err := parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+
+	fixture := models.DemoFixture()
+	if *file != "" {
+		if fixture, err = models.LoadFixture(*file); err != nil {
+			return err
+		}
+	}
+
+	db, err := models.Open(cfg.DB)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	res, err := db.Seed(context.Background(), fixture)
+	if err != nil {
+		return fmt.Errorf("failed to seed: %w", err)
+	}
+	fmt.Printf("Created %d categories, %d tags and %d items\n", res.Categories, res.Tags, res.Items)
+	return nil
+}
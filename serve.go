@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"gin-crud/internal/app"
+	"gin-crud/internal/certs"
+	"gin-crud/internal/jobs"
+	"gin-crud/internal/routes"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/gin-gonic/gin"
+)
+
+// runServe serves the API until SIGINT or SIGTERM, then shuts down in order:
+// in-flight requests are drained, the background jobs stop and finally the
+// database connection is closed
+func runServe(args []string) error {
+	flags := flag.NewFlagSet("gin-crud serve", flag.ContinueOnError)
+	cfg, err := parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+
+	// Load the certificate first so a bad one fails before the database is touched
+	var reloader *certs.Reloader
+	if cfg.TLS.Enabled() {
+		if reloader, err = certs.NewReloader(cfg.TLS, log.Default()); err != nil {
+			return err
+		}
+	}
+
+	// Connect to the database and load the JWT keys, storage and exchange rates
+	a, err := app.New(context.Background(), cfg, log.Default())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := a.Close(); err != nil {
+			log.Printf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	// Permanently remove items that were soft-deleted past the retention window
+	// and apply scheduled price changes
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	purgeDone := jobs.StartItemPurge(jobsCtx, a)
+	pricesDone := jobs.StartPriceScheduler(jobsCtx, a)
+
+	// Set up the Gin router with default middleware
+	router := gin.Default()
+
+	// Set up routes
+	routes.SetupRoutes(router, a)
+
+	srv := &http.Server{
+		Addr:           cfg.Server.Addr,
+		Handler:        router,
+		ReadTimeout:    cfg.Server.ReadTimeout,
+		WriteTimeout:   cfg.Server.WriteTimeout,
+		IdleTimeout:    cfg.Server.IdleTimeout,
+		MaxHeaderBytes: cfg.Server.MaxHeaderBytes,
+	}
+
+	serve := srv.ListenAndServe
+	if reloader != nil {
+		// Certificates renewed on disk are picked up without a restart
+		srv.TLSConfig = reloader.TLSConfig()
+		go reloader.Watch(jobsCtx)
+		serve = func() error { return srv.ListenAndServeTLS("", "") }
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Start the server
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Printf("Server started at %s (TLS: %t)", cfg.Server.Addr, reloader != nil)
+		serveErr <- serve()
+	}()
+
+	select {
+	case err = <-serveErr:
+		err = fmt.Errorf("server failed: %w", err)
+	case <-ctx.Done():
+		// A second signal kills the process without waiting for the drain
+		stop()
+		log.Printf("Shutting down, waiting up to %s for in-flight requests", cfg.Server.ShutdownTimeout)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	if serr := srv.Shutdown(shutdownCtx); serr != nil {
+		log.Printf("Failed to drain connections: %v", serr)
+		srv.Close()
+	}
+
+	// Jobs may be in the middle of a transaction, so let them finish before the client closes
+	stopJobs()
+	<-purgeDone
+	<-pricesDone
+
+	log.Println("Server stopped")
+	return err
+}
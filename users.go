@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"gin-crud/ent"
+	"gin-crud/ent/user"
+	"gin-crud/internal/models"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// passwordFlags are the ways a command can be given a password
+type passwordFlags struct {
+	password  *string
+	fromStdin *bool
+}
+
+func addPasswordFlags(flags *flag.FlagSet) passwordFlags {
+	return passwordFlags{
+		password:  flags.String("password", "", "password; prefer -password-stdin, flags are visible to other processes"),
+		fromStdin: flags.Bool("password-stdin", false, "read the password from the first line of stdin"),
+	}
+}
+
+// read returns the password from the flags. Without one a random password is
+// generated and generated is true, so the caller can show it once.
+func (p passwordFlags) read() (password string, generated bool, err error) {
+	switch {
+	case *p.fromStdin:
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return "", false, fmt.Errorf("failed to read password from stdin: %w", err)
+		}
+		password = strings.TrimRight(line, "\r\n")
+	case *p.password != "":
+		password = *p.password
+	default:
+		buf := make([]byte, 18)
+		if _, err := rand.Read(buf); err != nil {
+			return "", false, err
+		}
+		return base64.RawURLEncoding.EncodeToString(buf), true, nil
+	}
+
+	if password == "" {
+		return "", false, errors.New("password must not be empty")
+	}
+	return password, false, nil
+}
+
+// runCreateAdmin runs "create-admin -username name [-email address]"
+func runCreateAdmin(args []string) error {
+	flags := flag.NewFlagSet("gin-crud create-admin", flag.ContinueOnError)
+	username := flags.String("username", "", "username of the new admin (required)")
+	email := flags.String("email", "", "email of the new admin")
+	pw := addPasswordFlags(flags)
+	cfg, err := parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if *username == "" {
+		return errors.New("-username is required")
+	}
+	password, generated, err := pw.read()
+	if err != nil {
+		return err
+	}
+
+	db, err := models.Open(cfg.DB)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	u, err := db.CreateUser(context.Background(), *username, *email, password, user.RoleAdmin)
+	if ent.IsConstraintError(err) {
+		return fmt.Errorf("username or email already exists, use reset-password to change the password of an existing user")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create admin: %w", err)
+	}
+
+	fmt.Printf("Created admin %s with ID %d\n", u.Username, u.ID)
+	if generated {
+		fmt.Printf("Generated password: %s\n", password)
+	}
+	return nil
+}
+
+// runResetPassword runs "reset-password -username name"
+func runResetPassword(args []string) error {
+	flags := flag.NewFlagSet("gin-crud reset-password", flag.ContinueOnError)
+	username := flags.String("username", "", "user whose password is reset (required)")
+	pw := addPasswordFlags(flags)
+	cfg, err := parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if *username == "" {
+		return errors.New("-username is required")
+	}
+	password, generated, err := pw.read()
+	if err != nil {
+		return err
+	}
+
+	db, err := models.Open(cfg.DB)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	err = db.ResetPassword(context.Background(), *username, password)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("user %q not found", *username)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to reset password: %w", err)
+	}
+
+	fmt.Printf("Reset the password of %s and signed them out of every session\n", *username)
+	if generated {
+		fmt.Printf("Generated password: %s\n", password)
+	}
+	return nil
+}
+
+// runSetRole runs "set-role -username name -role role"
+func runSetRole(args []string) error {
+	flags := flag.NewFlagSet("gin-crud set-role", flag.ContinueOnError)
+	username := flags.String("username", "", "user to grant the role to (required)")
+	role := flags.String("role", "", "admin, editor or viewer (required)")
+	cfg, err := parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if *username == "" {
+		return errors.New("-username is required")
+	}
+	if err := user.RoleValidator(user.Role(*role)); err != nil {
+		return fmt.Errorf("invalid -role %q, use admin, editor or viewer", *role)
+	}
+
+	db, err := models.Open(cfg.DB)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	err = db.SetRole(context.Background(), *username, user.Role(*role))
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("user %q not found", *username)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set role: %w", err)
+	}
+
+	fmt.Printf("%s is now %s and was signed out of every session\n", *username, *role)
+	return nil
+}
+
+// runListUsers runs "list-users [-role role]"
+func runListUsers(args []string) error {
+	flags := flag.NewFlagSet("gin-crud list-users", flag.ContinueOnError)
+	role := flags.String("role", "", "only list users with this role: admin, editor or viewer")
+	cfg, err := parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+
+	db, err := models.Open(cfg.DB)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	query := db.Client.User.Query().Order(user.ByID())
+	if *role != "" {
+		if err := user.RoleValidator(user.Role(*role)); err != nil {
+			return fmt.Errorf("invalid -role %q, use admin, editor or viewer", *role)
+		}
+		query.Where(user.RoleEQ(user.Role(*role)))
+	}
+	users, err := query.All(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tUSERNAME\tEMAIL\tROLE")
+	for _, u := range users {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", u.ID, u.Username, u.Email, u.Role)
+	}
+	return w.Flush()
+}